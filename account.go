@@ -0,0 +1,328 @@
+package stripe
+
+import (
+	"encoding/json"
+	"net/url"
+)
+
+const (
+	AccountTypeStandard = "standard"
+	AccountTypeExpress  = "express"
+	AccountTypeCustom   = "custom"
+
+	BusinessTypeCompany          = "company"
+	BusinessTypeGovernmentEntity = "government_entity"
+	BusinessTypeIndividual       = "individual"
+	BusinessTypeNonProfit        = "non_profit"
+
+	AccountRejectReasonFraud          = "fraud"
+	AccountRejectReasonTermsOfService = "terms_of_service"
+	AccountRejectReasonOther          = "other"
+)
+
+// Account represents a Stripe account, such as a connected account of a platform
+type Account struct {
+	ID     string `json:"id"`
+	Object string `json:"object"`
+
+	// The Stripe account type. Can be standard, express, or custom.
+	Type string `json:"type"`
+	// The business type, see the BusinessType constants.
+	BusinessType *string `json:"business_type"`
+	// Business information about the account.
+	BusinessProfile *AccountBusinessProfile `json:"business_profile"`
+	// Information about the company or business, only present when BusinessType is company.
+	Company *AccountCompany `json:"company"`
+	// Information about the person represented by the account, only present when BusinessType is individual.
+	Individual *AccountIndividual `json:"individual"`
+	// A map of capability names to their status (active, inactive or pending).
+	Capabilities map[string]string `json:"capabilities"`
+	// External accounts (bank accounts and debit cards) currently attached to this account.
+	ExternalAccounts *ExternalAccountList `json:"external_accounts"`
+	// Information about the requirements for the account, including what information needs to be collected, and by when.
+	Requirements *AccountRequirements `json:"requirements"`
+
+	// Whether the account can create live charges.
+	ChargesEnabled bool `json:"charges_enabled"`
+	// Whether Stripe can send payouts to this account.
+	PayoutsEnabled bool `json:"payouts_enabled"`
+	// Whether account details have been submitted.
+	DetailsSubmitted bool `json:"details_submitted"`
+
+	// The account's country.
+	Country string `json:"country"`
+	// Three-letter ISO currency code representing the default currency for the account.
+	DefaultCurrency string `json:"default_currency"`
+	// An email address associated with the account.
+	Email *string `json:"email"`
+
+	Metadata Dictionary `json:"metadata"`
+	Created  int64      `json:"created"`
+}
+
+// AccountBusinessProfile represents the business information of an Account
+type AccountBusinessProfile struct {
+	// The customer-facing business name.
+	Name *string `json:"name"`
+	// The merchant category code for the account.
+	MCC *string `json:"mcc"`
+	// The business's publicly available website.
+	URL *string `json:"url"`
+	// Internal-only description of the product sold or service provided by the business.
+	ProductDescription *string `json:"product_description"`
+	// A publicly available email address for sending support issues to.
+	SupportEmail *string `json:"support_email"`
+	// A publicly available phone number to call with support issues.
+	SupportPhone *string `json:"support_phone"`
+}
+
+func (a *AccountBusinessProfile) AppendFormValues(values url.Values, key string) {
+	if a == nil {
+		return
+	}
+
+	setFormStringPtr(values, getFieldKey(key, "name"), a.Name)
+	setFormStringPtr(values, getFieldKey(key, "mcc"), a.MCC)
+	setFormStringPtr(values, getFieldKey(key, "url"), a.URL)
+	setFormStringPtr(values, getFieldKey(key, "product_description"), a.ProductDescription)
+	setFormStringPtr(values, getFieldKey(key, "support_email"), a.SupportEmail)
+	setFormStringPtr(values, getFieldKey(key, "support_phone"), a.SupportPhone)
+}
+
+// AccountCompany represents the company information of an Account
+type AccountCompany struct {
+	// The company's legal name.
+	Name *string `json:"name"`
+	// The company's primary address.
+	Address *Address `json:"address"`
+	// The company's phone number (used for verification).
+	Phone *string `json:"phone"`
+	// The business ID number of the company, as appropriate for the company's country. Only used on create and update.
+	TaxID *string `json:"tax_id"`
+	// Whether the company's business ID number was provided.
+	TaxIDProvided bool `json:"tax_id_provided"`
+}
+
+func (a *AccountCompany) AppendFormValues(values url.Values, key string) {
+	if a == nil {
+		return
+	}
+
+	setFormStringPtr(values, getFieldKey(key, "name"), a.Name)
+	setFormStringPtr(values, getFieldKey(key, "phone"), a.Phone)
+	setFormStringPtr(values, getFieldKey(key, "tax_id"), a.TaxID)
+	a.Address.AppendFormValues(values, getFieldKey(key, "address"))
+}
+
+// AccountIndividual represents the person of an individual Account
+type AccountIndividual struct {
+	// The individual's first name.
+	FirstName *string `json:"first_name"`
+	// The individual's last name.
+	LastName *string `json:"last_name"`
+	// The individual's email address.
+	Email *string `json:"email"`
+	// The individual's phone number.
+	Phone *string `json:"phone"`
+	// The individual's date of birth.
+	DOB *DateOfBirth `json:"dob"`
+	// The individual's primary address.
+	Address *Address `json:"address"`
+}
+
+func (a *AccountIndividual) AppendFormValues(values url.Values, key string) {
+	if a == nil {
+		return
+	}
+
+	setFormStringPtr(values, getFieldKey(key, "first_name"), a.FirstName)
+	setFormStringPtr(values, getFieldKey(key, "last_name"), a.LastName)
+	setFormStringPtr(values, getFieldKey(key, "email"), a.Email)
+	setFormStringPtr(values, getFieldKey(key, "phone"), a.Phone)
+	a.DOB.AppendFormValues(values, getFieldKey(key, "dob"))
+	a.Address.AppendFormValues(values, getFieldKey(key, "address"))
+}
+
+// DateOfBirth represents the date of birth of an individual
+type DateOfBirth struct {
+	Day   int64 `json:"day"`
+	Month int64 `json:"month"`
+	Year  int64 `json:"year"`
+}
+
+func (d *DateOfBirth) AppendFormValues(values url.Values, key string) {
+	if d == nil {
+		return
+	}
+
+	setFormInt64(values, getFieldKey(key, "day"), d.Day)
+	setFormInt64(values, getFieldKey(key, "month"), d.Month)
+	setFormInt64(values, getFieldKey(key, "year"), d.Year)
+}
+
+// AccountRequirements represent the information which needs to be collected for an Account
+type AccountRequirements struct {
+	// Fields that need to be collected to keep the account enabled.
+	CurrentlyDue []string `json:"currently_due"`
+	// Fields that need to be collected assuming all volume thresholds are reached.
+	EventuallyDue []string `json:"eventually_due"`
+	// Fields that weren't collected by CurrentDeadline. These fields need to be collected to enable the account.
+	PastDue []string `json:"past_due"`
+	// Fields that might become required depending on the results of verification or review.
+	PendingVerification []string `json:"pending_verification"`
+	// Date by which the fields in CurrentlyDue must be collected to keep the account enabled.
+	CurrentDeadline *int64 `json:"current_deadline"`
+	// If the account is disabled, this string describes why.
+	DisabledReason *string `json:"disabled_reason"`
+}
+
+// AccountTOSAcceptance represents the acceptance of the Stripe Services Agreement
+type AccountTOSAcceptance struct {
+	// The Unix timestamp marking when the account representative accepted their service agreement.
+	Date int64 `json:"date"`
+	// The IP address from which the account representative accepted their service agreement.
+	IP string `json:"ip"`
+}
+
+func (a *AccountTOSAcceptance) AppendFormValues(values url.Values, key string) {
+	if a == nil {
+		return
+	}
+
+	setFormInt64(values, getFieldKey(key, "date"), a.Date)
+	setFormString(values, getFieldKey(key, "ip"), a.IP)
+}
+
+// AccountRequest is used to create or update an Account
+type AccountRequest struct {
+	// The type of Stripe account to create, one of standard, express, or custom. Required on creation, ignored on update.
+	Type string `json:"type"`
+	// The country in which the account holder resides, or in which the business is legally established. Only used on creation. (Optional)
+	Country *string `json:"country"`
+	// The email address of the account holder. (Optional)
+	Email *string `json:"email"`
+	// The business type, see the BusinessType constants. (Optional)
+	BusinessType *string `json:"business_type"`
+	// Business information about the account. (Optional)
+	BusinessProfile *AccountBusinessProfile `json:"business_profile"`
+	// Information about the company or business. (Optional)
+	Company *AccountCompany `json:"company"`
+	// Information about the person represented by the account. (Optional)
+	Individual *AccountIndividual `json:"individual"`
+	// The names of the capabilities to request, such as card_payments and transfers. (Optional)
+	Capabilities []string `json:"capabilities"`
+	// Three-letter ISO currency code representing the default currency for the account. (Optional)
+	DefaultCurrency *string `json:"default_currency"`
+	// Details on the account's acceptance of the Stripe Services Agreement. (Optional)
+	TOSAcceptance *AccountTOSAcceptance `json:"tos_acceptance"`
+
+	Metadata Dictionary `json:"metadata"`
+}
+
+func (a *AccountRequest) ToFormValues() (form url.Values) {
+	// Pre-allocate values with enough space for the basic account rows
+	form = make(url.Values, 4)
+	setFormString(form, "type", a.Type)
+	setFormStringPtr(form, "country", a.Country)
+	setFormStringPtr(form, "email", a.Email)
+	setFormStringPtr(form, "business_type", a.BusinessType)
+	setFormStringPtr(form, "default_currency", a.DefaultCurrency)
+	for _, capability := range a.Capabilities {
+		form.Set(getFieldKey(getFieldKey("capabilities", capability), "requested"), "true")
+	}
+
+	a.BusinessProfile.AppendFormValues(form, "business_profile")
+	a.Company.AppendFormValues(form, "company")
+	a.Individual.AppendFormValues(form, "individual")
+	a.TOSAcceptance.AppendFormValues(form, "tos_acceptance")
+	a.Metadata.AppendFormValues(form, "metadata")
+	return
+}
+
+// AccountListRequest is used to list connected Accounts
+type AccountListRequest struct {
+	ListParams
+
+	// Only return connected accounts that were created during the given date interval. (Optional)
+	Created *RangeQuery `json:"created"`
+}
+
+func (a *AccountListRequest) ToFormValues() (form url.Values) {
+	form = a.ListParams.ToFormValues()
+	a.Created.AppendFormValues(form, "created")
+	return
+}
+
+// AccountList is a paginated list of Accounts
+type AccountList struct {
+	List
+	Data []Account `json:"data"`
+}
+
+type accountRejectRequest struct {
+	Reason string `json:"reason"`
+}
+
+func (a *accountRejectRequest) ToFormValues() (form url.Values) {
+	form = make(url.Values, 1)
+	setFormString(form, "reason", a.Reason)
+	return
+}
+
+func (c *Client) CreateAccount(request AccountRequest) (created Account, err error) {
+	err = c.request("POST", endpointAccounts, &request, &created)
+	return
+}
+
+func (c *Client) GetAccount(accountID string) (account Account, err error) {
+	err = c.request("GET", endpointAccountsWithID, nil, &account, accountID)
+	return
+}
+
+func (c *Client) UpdateAccount(accountID string, request AccountRequest) (updated Account, err error) {
+	// The type and country of an account cannot be changed
+	request.Type = ""
+	request.Country = nil
+
+	err = c.request("POST", endpointAccountsWithID, &request, &updated, accountID)
+	return
+}
+
+func (c *Client) RemoveAccount(accountID string) (err error) {
+	err = c.request("DELETE", endpointAccountsWithID, nil, nil, accountID)
+	return
+}
+
+// RejectAccount will flag a custom or express Account as suspicious, reason must be one of fraud, terms_of_service, or other
+func (c *Client) RejectAccount(accountID, reason string) (rejected Account, err error) {
+	var req accountRejectRequest
+	req.Reason = reason
+	err = c.request("POST", endpointAccountsRejectWithID, &req, &rejected, accountID)
+	return
+}
+
+func (c *Client) ListAccounts(request AccountListRequest) (list AccountList, err error) {
+	err = c.request("GET", endpointAccounts, &request, &list)
+	return
+}
+
+// ExpandableAccount references an Account by ID, the Account itself is only present when the field is expanded
+type ExpandableAccount struct {
+	ID      string
+	Account *Account
+}
+
+func (e *ExpandableAccount) UnmarshalJSON(bs []byte) (err error) {
+	if err = json.Unmarshal(bs, &e.ID); err == nil {
+		return
+	}
+
+	var a Account
+	if err = json.Unmarshal(bs, &a); err != nil {
+		return
+	}
+
+	e.ID = a.ID
+	e.Account = &a
+	return
+}
@@ -0,0 +1,68 @@
+package stripe
+
+import (
+	"net/url"
+)
+
+const (
+	AccountLinkTypeOnboarding = "account_onboarding"
+	AccountLinkTypeUpdate     = "account_update"
+
+	AccountLinkCollectCurrentlyDue  = "currently_due"
+	AccountLinkCollectEventuallyDue = "eventually_due"
+)
+
+// AccountLink represents a single-use URL which takes a connected Account through the Stripe onboarding flow
+type AccountLink struct {
+	Object string `json:"object"`
+
+	// The URL for the account link.
+	URL string `json:"url"`
+	// The timestamp at which this account link will expire.
+	ExpiresAt int64 `json:"expires_at"`
+	Created   int64 `json:"created"`
+}
+
+// AccountLinkRequest is used to create an AccountLink
+type AccountLinkRequest struct {
+	// The identifier of the account to create an account link for.
+	Account string `json:"account"`
+	// The type of account link the user is requesting, either account_onboarding or account_update.
+	Type string `json:"type"`
+	// The URL the user will be redirected to if the account link is expired, has been previously-visited, or is otherwise invalid.
+	RefreshURL string `json:"refresh_url"`
+	// The URL that the user will be redirected to upon leaving or completing the linked flow.
+	ReturnURL string `json:"return_url"`
+	// Which information the platform needs to collect from the user, either currently_due or eventually_due. (Optional)
+	Collect *string `json:"collect"`
+}
+
+func (a *AccountLinkRequest) ToFormValues() (form url.Values) {
+	form = make(url.Values, 5)
+	setFormString(form, "account", a.Account)
+	setFormString(form, "type", a.Type)
+	setFormString(form, "refresh_url", a.RefreshURL)
+	setFormString(form, "return_url", a.ReturnURL)
+	setFormStringPtr(form, "collect", a.Collect)
+	return
+}
+
+// LoginLink represents a single-use URL which logs an express Account into their Stripe dashboard
+type LoginLink struct {
+	Object string `json:"object"`
+
+	// The URL for the login link.
+	URL     string `json:"url"`
+	Created int64  `json:"created"`
+}
+
+func (c *Client) CreateAccountLink(request AccountLinkRequest) (created AccountLink, err error) {
+	err = c.request("POST", endpointAccountLinks, &request, &created)
+	return
+}
+
+// CreateLoginLink will create a single-use login link for an express Account
+func (c *Client) CreateLoginLink(accountID string) (created LoginLink, err error) {
+	err = c.request("POST", endpointLoginLinksWithID, nil, &created, accountID)
+	return
+}
@@ -0,0 +1,65 @@
+package stripe
+
+import "testing"
+
+func TestAccountRequest_ToFormValues(t *testing.T) {
+	var req AccountRequest
+	req.Type = AccountTypeExpress
+	req.Country = String("US")
+	req.Capabilities = []string{"card_payments", "transfers"}
+	req.BusinessType = String(BusinessTypeIndividual)
+	req.Individual = &AccountIndividual{
+		FirstName: String("Leeroy"),
+		DOB:       &DateOfBirth{Day: 1, Month: 2, Year: 1990},
+	}
+
+	form := req.ToFormValues()
+	tcs := []struct {
+		key      string
+		expected string
+	}{
+		{key: "type", expected: "express"},
+		{key: "country", expected: "US"},
+		{key: "capabilities[card_payments][requested]", expected: "true"},
+		{key: "capabilities[transfers][requested]", expected: "true"},
+		{key: "business_type", expected: "individual"},
+		{key: "individual[first_name]", expected: "Leeroy"},
+		{key: "individual[dob][year]", expected: "1990"},
+	}
+
+	for _, tc := range tcs {
+		if value := form.Get(tc.key); value != tc.expected {
+			t.Fatalf("invalid value for <%s>, expected <%s> and received <%s>", tc.key, tc.expected, value)
+		}
+	}
+
+	if _, ok := form["company[name]"]; ok {
+		t.Fatal("invalid form values, company should not be sent")
+	}
+}
+
+func TestClient_UpdateAccount(t *testing.T) {
+	s, form := newFormServer(t, `{"id":"acct_123","object":"account"}`)
+	defer s.Close()
+
+	var req AccountRequest
+	req.Type = AccountTypeExpress
+	req.Country = String("US")
+	req.Email = String("leeroy@example.com")
+
+	c := newTestServerClient(t, s.URL)
+	if _, err := c.UpdateAccount("acct_123", req); err != nil {
+		t.Fatal(err)
+	}
+
+	sent := form()
+	for _, key := range []string{"type", "country"} {
+		if _, ok := sent[key]; ok {
+			t.Fatalf("invalid form values, <%s> should not be sent on update", key)
+		}
+	}
+
+	if sent.Get("email") != "leeroy@example.com" {
+		t.Fatalf("invalid email, expected <%s> and received <%s>", "leeroy@example.com", sent.Get("email"))
+	}
+}
@@ -5,15 +5,15 @@ import "net/url"
 type Address struct {
 	// City, district, suburb, town, or village. (Optional)
 	City string `json:"city"`
-	// City, district, suburb, town, or village. (Optional)
+	// Two-letter country code (ISO 3166-1 alpha-2). (Optional)
 	Country string `json:"country"`
-	// City, district, suburb, town, or village. (Optional)
+	// Address line 1 (e.g., street, PO Box, or company name). (Optional)
 	Line1 string `json:"line1"`
-	// City, district, suburb, town, or village. (Optional)
+	// Address line 2 (e.g., apartment, suite, unit, or building). (Optional)
 	Line2 string `json:"line2"`
-	// City, district, suburb, town, or village. (Optional)
+	// ZIP or postal code. (Optional)
 	PostalCode string `json:"postal_code"`
-	// City, district, suburb, town, or village. (Optional)
+	// State, county, province, or region. (Optional)
 	State string `json:"state"`
 }
 
@@ -22,5 +22,10 @@ func (a *Address) AppendFormValues(values url.Values, key string) {
 		return
 	}
 
-	// TODO: Implement this
+	setFormString(values, getFieldKey(key, "city"), a.City)
+	setFormString(values, getFieldKey(key, "country"), a.Country)
+	setFormString(values, getFieldKey(key, "line1"), a.Line1)
+	setFormString(values, getFieldKey(key, "line2"), a.Line2)
+	setFormString(values, getFieldKey(key, "postal_code"), a.PostalCode)
+	setFormString(values, getFieldKey(key, "state"), a.State)
 }
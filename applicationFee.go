@@ -0,0 +1,138 @@
+package stripe
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// ApplicationFee represents a fee collected by the platform from a Charge made on a connected Account
+type ApplicationFee struct {
+	ID     string `json:"id"`
+	Object string `json:"object"`
+
+	// The connected account that the application fee was collected from, the Account is present when expanded.
+	Account ExpandableAccount `json:"account"`
+	// The charge the application fee was collected for, the Charge is present when expanded.
+	Charge ExpandableCharge `json:"charge"`
+	// Amount earned, in cents (or local equivalent).
+	Amount int64 `json:"amount"`
+	// Amount in cents (or local equivalent) refunded (can be less than the amount attribute on the fee if a partial refund was issued).
+	AmountRefunded int64 `json:"amount_refunded"`
+	// Three-letter ISO currency code, in lowercase.
+	Currency string `json:"currency"`
+	// ID of the Connect application that earned the fee.
+	Application string `json:"application"`
+	// Balance transaction that describes the impact of this collected application fee on your account balance (not including refunds).
+	BalanceTransaction *string `json:"balance_transaction"`
+	// ID of the corresponding charge on the platform account, if this fee was the result of a charge using the destination parameter.
+	OriginatingTransaction *string `json:"originating_transaction"`
+	// Whether the fee has been fully refunded. If the fee is only partially refunded, this attribute will still be false.
+	Refunded bool `json:"refunded"`
+	// A list of refunds that have been applied to the fee.
+	Refunds ApplicationFeeRefundList `json:"refunds"`
+
+	Livemode bool  `json:"livemode"`
+	Created  int64 `json:"created"`
+}
+
+// ApplicationFeeListRequest is used to list ApplicationFees
+type ApplicationFeeListRequest struct {
+	ListParams
+
+	// Only return application fees for the charge specified by this charge ID. (Optional)
+	Charge *string `json:"charge"`
+	// Only return application fees that were created during the given date interval. (Optional)
+	Created *RangeQuery `json:"created"`
+	// The fields to expand, such as charge and account. (Optional)
+	Expand []string `json:"expand"`
+}
+
+func (a *ApplicationFeeListRequest) ToFormValues() (form url.Values) {
+	form = a.ListParams.ToFormValues()
+	setFormStringPtr(form, "charge", a.Charge)
+	a.Created.AppendFormValues(form, "created")
+	// List fields are expanded per item within the data array
+	for i, field := range a.Expand {
+		form.Set(fmt.Sprintf("expand[%d]", i), "data."+field)
+	}
+
+	return
+}
+
+// ApplicationFeeList is a paginated list of ApplicationFees
+type ApplicationFeeList struct {
+	List
+	Data []ApplicationFee `json:"data"`
+}
+
+// ApplicationFeeRefund represents the return of an ApplicationFee to the connected Account
+type ApplicationFeeRefund struct {
+	ID     string `json:"id"`
+	Object string `json:"object"`
+
+	// Amount, in cents (or local equivalent).
+	Amount int64 `json:"amount"`
+	// Three-letter ISO currency code, in lowercase.
+	Currency string `json:"currency"`
+	// Balance transaction that describes the impact on your account balance.
+	BalanceTransaction *string `json:"balance_transaction"`
+	// ID of the application fee that was refunded.
+	Fee string `json:"fee"`
+
+	Metadata Dictionary `json:"metadata"`
+	Created  int64      `json:"created"`
+}
+
+// ApplicationFeeRefundRequest is used to create an ApplicationFeeRefund
+type ApplicationFeeRefundRequest struct {
+	// A positive integer, in cents (or local equivalent), representing how much of this fee to refund. Defaults to the entire application fee. (Optional)
+	Amount *int64 `json:"amount"`
+
+	Metadata Dictionary `json:"metadata"`
+}
+
+func (a *ApplicationFeeRefundRequest) ToFormValues() (form url.Values) {
+	form = make(url.Values, 1)
+	setFormInt64Ptr(form, "amount", a.Amount)
+	a.Metadata.AppendFormValues(form, "metadata")
+	return
+}
+
+// ApplicationFeeRefundList is a paginated list of ApplicationFeeRefunds
+type ApplicationFeeRefundList struct {
+	List
+	Data []ApplicationFeeRefund `json:"data"`
+}
+
+type expandRequest struct {
+	Expand []string `json:"expand"`
+}
+
+func (e *expandRequest) ToFormValues() (form url.Values) {
+	form = make(url.Values, len(e.Expand))
+	setFormStringSlice(form, "expand", e.Expand)
+	return
+}
+
+// GetApplicationFee will retrieve an ApplicationFee, the provided fields (such as charge and account) are expanded
+func (c *Client) GetApplicationFee(applicationFeeID string, expand ...string) (fee ApplicationFee, err error) {
+	var req expandRequest
+	req.Expand = expand
+	err = c.request("GET", endpointApplicationFeesWithID, &req, &fee, applicationFeeID)
+	return
+}
+
+func (c *Client) ListApplicationFees(request ApplicationFeeListRequest) (list ApplicationFeeList, err error) {
+	err = c.request("GET", endpointApplicationFees, &request, &list)
+	return
+}
+
+func (c *Client) CreateApplicationFeeRefund(applicationFeeID string, request ApplicationFeeRefundRequest) (created ApplicationFeeRefund, err error) {
+	err = c.request("POST", endpointApplicationFeeRefundsWithID, &request, &created, applicationFeeID)
+	return
+}
+
+func (c *Client) ListApplicationFeeRefunds(applicationFeeID string, params ListParams) (list ApplicationFeeRefundList, err error) {
+	err = c.request("GET", endpointApplicationFeeRefundsWithID, &params, &list, applicationFeeID)
+	return
+}
@@ -0,0 +1,47 @@
+package stripe
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestApplicationFee_expandable(t *testing.T) {
+	r := strings.NewReader(`{
+		"id": "fee_123",
+		"object": "application_fee",
+		"account": { "id": "acct_123", "object": "account", "type": "express", "charges_enabled": true },
+		"charge": "ch_123",
+		"amount": 100,
+		"currency": "usd"
+	}`)
+
+	var fee ApplicationFee
+	if err := handleResponse(r, &fee); err != nil {
+		t.Fatal(err)
+	}
+
+	switch {
+	case fee.Account.ID != "acct_123":
+		t.Fatalf("invalid account ID, expected <%s> and received <%s>", "acct_123", fee.Account.ID)
+	case fee.Account.Account == nil || fee.Account.Account.Type != AccountTypeExpress:
+		t.Fatalf("invalid account, expected expanded %s account and received %+v", AccountTypeExpress, fee.Account.Account)
+	case fee.Charge.ID != "ch_123":
+		t.Fatalf("invalid charge ID, expected <%s> and received <%s>", "ch_123", fee.Charge.ID)
+	case fee.Charge.Charge != nil:
+		t.Fatalf("invalid charge, expected <nil> and received %+v", fee.Charge.Charge)
+	}
+}
+
+func TestApplicationFeeListRequest_ToFormValues(t *testing.T) {
+	var req ApplicationFeeListRequest
+	req.Limit = Int64(10)
+	req.Expand = []string{"charge", "account"}
+
+	form := req.ToFormValues()
+	switch {
+	case form.Get("expand[0]") != "data.charge":
+		t.Fatalf("invalid expand, expected <%s> and received <%s>", "data.charge", form.Get("expand[0]"))
+	case form.Get("expand[1]") != "data.account":
+		t.Fatalf("invalid expand, expected <%s> and received <%s>", "data.account", form.Get("expand[1]"))
+	}
+}
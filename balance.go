@@ -0,0 +1,32 @@
+package stripe
+
+// Balance represents the funds in a Stripe account, broken down by currency
+type Balance struct {
+	Object string `json:"object"`
+
+	// Available funds that you can transfer or pay out automatically by Stripe or explicitly through the Transfers API or Payouts API.
+	Available []BalanceAmount `json:"available"`
+	// Funds that aren't available in the balance yet. You can find the pending balance for each currency and each payment type in the SourceTypes property.
+	Pending []BalanceAmount `json:"pending"`
+	// Funds held due to negative balances on connected accounts.
+	ConnectReserved []BalanceAmount `json:"connect_reserved"`
+	// Funds that you can pay out using Instant Payouts.
+	InstantAvailable []BalanceAmount `json:"instant_available"`
+
+	Livemode bool `json:"livemode"`
+}
+
+// BalanceAmount represents the funds of a single currency within a Balance
+type BalanceAmount struct {
+	// Balance amount.
+	Amount int64 `json:"amount"`
+	// Three-letter ISO currency code, in lowercase.
+	Currency string `json:"currency"`
+	// Breakdown of the amount by source type (e.g. card, bank_account or fpx).
+	SourceTypes map[string]int64 `json:"source_types"`
+}
+
+func (c *Client) GetBalance() (balance Balance, err error) {
+	err = c.request("GET", endpointBalance, nil, &balance)
+	return
+}
@@ -0,0 +1,151 @@
+package stripe
+
+import (
+	"net/url"
+)
+
+const (
+	BalanceTransactionStatusAvailable = "available"
+	BalanceTransactionStatusPending   = "pending"
+
+	BalanceTransactionTypeAdjustment           = "adjustment"
+	BalanceTransactionTypeApplicationFee       = "application_fee"
+	BalanceTransactionTypeApplicationFeeRefund = "application_fee_refund"
+	BalanceTransactionTypeCharge               = "charge"
+	BalanceTransactionTypePayment              = "payment"
+	BalanceTransactionTypePayout               = "payout"
+	BalanceTransactionTypePayoutCancel         = "payout_cancel"
+	BalanceTransactionTypePayoutFailure        = "payout_failure"
+	BalanceTransactionTypeRefund               = "refund"
+	BalanceTransactionTypeStripeFee            = "stripe_fee"
+	BalanceTransactionTypeTransfer             = "transfer"
+	BalanceTransactionTypeTransferRefund       = "transfer_refund"
+	BalanceTransactionTypeTransferCancel       = "transfer_cancel"
+	BalanceTransactionTypeTransferFailure      = "transfer_failure"
+
+	FeeTypeApplicationFee = "application_fee"
+	FeeTypeStripeFee      = "stripe_fee"
+	FeeTypeTax            = "tax"
+)
+
+// BalanceTransaction represents funds moving through a Stripe account
+type BalanceTransaction struct {
+	ID     string `json:"id"`
+	Object string `json:"object"`
+
+	// Gross amount of this transaction (in cents (or local equivalent)).
+	Amount int64 `json:"amount"`
+	// Three-letter ISO currency code, in lowercase.
+	Currency string `json:"currency"`
+	// Fees (in cents (or local equivalent)) paid for this transaction.
+	Fee int64 `json:"fee"`
+	// Detailed breakdown of fees (in cents (or local equivalent)) paid for this transaction.
+	FeeDetails []FeeDetail `json:"fee_details"`
+	// Net impact to a Stripe balance (in cents (or local equivalent)). A positive value represents incrementing a Stripe balance, and a negative value decrementing a Stripe balance.
+	Net int64 `json:"net"`
+	// If applicable, this transaction uses an exchange rate. If money converts from currency A to currency B, then the amount in currency A, multipled by the exchange rate, equals the amount in currency B.
+	ExchangeRate *float64 `json:"exchange_rate"`
+	// Learn more about how reporting categories can help you understand balance transactions from an accounting perspective.
+	ReportingCategory string `json:"reporting_category"`
+	// The transaction's net funds status in the Stripe balance, which are either available or pending.
+	Status string `json:"status"`
+	// Transaction type, see the BalanceTransactionType constants.
+	Type string `json:"type"`
+	// This transaction relates to the Stripe object (e.g. a Charge or Refund).
+	Source Source `json:"source"`
+	// An arbitrary string attached to the object. Often useful for displaying to users.
+	Description *string `json:"description"`
+
+	// The date that the transaction's net funds become available in the Stripe balance.
+	AvailableOn int64 `json:"available_on"`
+	Created     int64 `json:"created"`
+}
+
+// FeeDetail represents a single fee paid for a BalanceTransaction
+type FeeDetail struct {
+	// Amount of the fee, in cents.
+	Amount int64 `json:"amount"`
+	// ID of the Connect application that earned the fee.
+	Application *string `json:"application"`
+	// Three-letter ISO currency code, in lowercase.
+	Currency string `json:"currency"`
+	// An arbitrary string attached to the object. Often useful for displaying to users.
+	Description *string `json:"description"`
+	// Type of the fee, one of: application_fee, stripe_fee or tax.
+	Type string `json:"type"`
+}
+
+// BalanceTransactionListRequest is used to list BalanceTransactions
+type BalanceTransactionListRequest struct {
+	ListParams
+
+	// For automatic Stripe payouts only, only returns transactions that were paid out on the specified payout ID. (Optional)
+	Payout *string `json:"payout"`
+	// Only returns the original transaction. (Optional)
+	Source *string `json:"source"`
+	// Only returns transactions of the given type, see the BalanceTransactionType constants. (Optional)
+	Type *string `json:"type"`
+	// Only return transactions in a certain currency. (Optional)
+	Currency *string `json:"currency"`
+	// Only return transactions that were created during the given date interval. (Optional)
+	Created *RangeQuery `json:"created"`
+}
+
+func (b *BalanceTransactionListRequest) ToFormValues() (form url.Values) {
+	form = b.ListParams.ToFormValues()
+	setFormStringPtr(form, "payout", b.Payout)
+	setFormStringPtr(form, "source", b.Source)
+	setFormStringPtr(form, "type", b.Type)
+	setFormStringPtr(form, "currency", b.Currency)
+	b.Created.AppendFormValues(form, "created")
+	return
+}
+
+// BalanceTransactionList is a paginated list of BalanceTransactions
+type BalanceTransactionList struct {
+	List
+	Data []BalanceTransaction `json:"data"`
+}
+
+func (c *Client) GetBalanceTransaction(balanceTransactionID string) (transaction BalanceTransaction, err error) {
+	err = c.request("GET", endpointBalanceTransactionsWithID, nil, &transaction, balanceTransactionID)
+	return
+}
+
+func (c *Client) ListBalanceTransactions(request BalanceTransactionListRequest) (list BalanceTransactionList, err error) {
+	err = c.request("GET", endpointBalanceTransactions, &request, &list)
+	return
+}
+
+// BalanceTransactionIterator iterates over every BalanceTransaction of a paginated list
+type BalanceTransactionIterator struct {
+	*Iterator
+}
+
+// BalanceTransaction returns the BalanceTransaction the iterator is currently at
+func (b *BalanceTransactionIterator) BalanceTransaction() (transaction BalanceTransaction) {
+	transaction, _ = b.Current().(BalanceTransaction)
+	return
+}
+
+// IterateBalanceTransactions will return an iterator over every BalanceTransaction matching the request, fetching pages as needed
+func (c *Client) IterateBalanceTransactions(request BalanceTransactionListRequest) *BalanceTransactionIterator {
+	fetch := func(params ListParams) (page []interface{}, lastID string, hasMore bool, err error) {
+		request.ListParams = params
+
+		var list BalanceTransactionList
+		if list, err = c.ListBalanceTransactions(request); err != nil {
+			return
+		}
+
+		for _, transaction := range list.Data {
+			page = append(page, transaction)
+			lastID = transaction.ID
+		}
+
+		hasMore = list.HasMore
+		return
+	}
+
+	return &BalanceTransactionIterator{newIterator(request.ListParams, fetch)}
+}
@@ -0,0 +1,38 @@
+package stripe
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestBalanceTransaction_decode(t *testing.T) {
+	r := strings.NewReader(`{
+		"id": "txn_123",
+		"object": "balance_transaction",
+		"amount": 1337,
+		"currency": "usd",
+		"fee": 69,
+		"fee_details": [{ "amount": 69, "currency": "usd", "description": "Stripe processing fees", "type": "stripe_fee" }],
+		"net": 1268,
+		"exchange_rate": null,
+		"reporting_category": "charge",
+		"status": "available",
+		"type": "charge",
+		"source": { "id": "ch_123", "object": "charge" },
+		"available_on": 1600000000
+	}`)
+
+	var txn BalanceTransaction
+	if err := handleResponse(r, &txn); err != nil {
+		t.Fatal(err)
+	}
+
+	switch {
+	case txn.Source != "ch_123":
+		t.Fatalf("invalid source, expected <%s> and received <%s>", "ch_123", txn.Source)
+	case txn.Net != txn.Amount-txn.Fee:
+		t.Fatalf("invalid net, expected %d and received %d", txn.Amount-txn.Fee, txn.Net)
+	case len(txn.FeeDetails) != 1 || txn.FeeDetails[0].Type != FeeTypeStripeFee:
+		t.Fatalf("invalid fee details, expected a single %s fee and received %v", FeeTypeStripeFee, txn.FeeDetails)
+	}
+}
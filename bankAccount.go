@@ -0,0 +1,69 @@
+package stripe
+
+import "net/url"
+
+const (
+	AccountHolderTypeIndividual = "individual"
+	AccountHolderTypeCompany    = "company"
+
+	BankAccountStatusNew                = "new"
+	BankAccountStatusValidated          = "validated"
+	BankAccountStatusVerified           = "verified"
+	BankAccountStatusVerificationFailed = "verification_failed"
+	BankAccountStatusErrored            = "errored"
+)
+
+// BankAccount represents a bank account, such as the external account of a connected Account
+type BankAccount struct {
+	// System fields
+	ID     string `json:"id"`
+	Object string `json:"object"`
+
+	// Required fields
+	// The account number for the bank account, in string form. Must be a checking account.
+	AccountNumber string `json:"account_number"`
+	// The country in which the bank account is located.
+	Country string `json:"country"`
+	// The currency the bank account is in. This must be a country/currency pairing that Stripe supports.
+	Currency string `json:"currency"`
+
+	// Usually required fields
+	// The routing number, sort code, or other country-appropriate institution number for the bank account. Required for US bank accounts.
+	RoutingNumber *string `json:"routing_number"`
+
+	// Optional fields
+	// The name of the person or business that owns the bank account.
+	AccountHolderName *string `json:"account_holder_name"`
+	// The type of entity that holds the account, either individual or company.
+	AccountHolderType *string `json:"account_holder_type"`
+
+	// Returned by system, not needed for creation/update
+	// The ID of the connected account the bank account belongs to.
+	Account *string `json:"account"`
+	// Name of the bank associated with the routing number (e.g., WELLS FARGO).
+	BankName *string `json:"bank_name"`
+	// Whether this bank account is the default external account for its currency.
+	DefaultForCurrency bool `json:"default_for_currency"`
+	// The status of the bank account, see the BankAccountStatus constants.
+	Status      string     `json:"status"`
+	Fingerprint string     `json:"fingerprint"`
+	LastFour    string     `json:"last4"`
+	Metadata    Dictionary `json:"metadata"`
+}
+
+func (b *BankAccount) AppendFormValues(form url.Values, key string) {
+	setFormString(form, getFieldKey(key, "account_number"), b.AccountNumber)
+	setFormString(form, getFieldKey(key, "country"), b.Country)
+	setFormString(form, getFieldKey(key, "currency"), b.Currency)
+	setFormStringPtr(form, getFieldKey(key, "routing_number"), b.RoutingNumber)
+	setFormStringPtr(form, getFieldKey(key, "account_holder_name"), b.AccountHolderName)
+	setFormStringPtr(form, getFieldKey(key, "account_holder_type"), b.AccountHolderType)
+}
+
+// CreateBankAccountToken will tokenize a BankAccount, the token can be used to add an external account to an Account
+func (c *Client) CreateBankAccountToken(bankAccount BankAccount) (created Token, err error) {
+	var token Token
+	token.BankAccount = &bankAccount
+	err = c.request("POST", endpointTokens, &token, &created)
+	return
+}
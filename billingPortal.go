@@ -0,0 +1,419 @@
+package stripe
+
+import (
+	"fmt"
+	"net/url"
+)
+
+const (
+	BillingPortalFlowTypePaymentMethodUpdate       = "payment_method_update"
+	BillingPortalFlowTypeSubscriptionCancel        = "subscription_cancel"
+	BillingPortalFlowTypeSubscriptionUpdate        = "subscription_update"
+	BillingPortalFlowTypeSubscriptionUpdateConfirm = "subscription_update_confirm"
+
+	BillingPortalAfterCompletionHostedConfirmation = "hosted_confirmation"
+	BillingPortalAfterCompletionPortalHomepage     = "portal_homepage"
+	BillingPortalAfterCompletionRedirect           = "redirect"
+
+	BillingPortalCancelModeAtPeriodEnd = "at_period_end"
+	BillingPortalCancelModeImmediately = "immediately"
+)
+
+// BillingPortalSession represents a session of the customer portal, where customers manage their payment methods and subscriptions
+type BillingPortalSession struct {
+	ID     string `json:"id"`
+	Object string `json:"object"`
+
+	// The ID of the customer for this session.
+	Customer string `json:"customer"`
+	// The configuration used by this session, describing the features available.
+	Configuration string `json:"configuration"`
+	// Information about a specific flow for the customer to go through.
+	Flow *BillingPortalFlowData `json:"flow"`
+	// The IETF language tag of the locale Customer Portal is displayed in.
+	Locale *string `json:"locale"`
+	// The URL to redirect customers to when they click on the portal's link to return to your website.
+	ReturnURL *string `json:"return_url"`
+	// The short-lived URL of the session that gives customers access to the customer portal.
+	URL string `json:"url"`
+
+	Livemode bool  `json:"livemode"`
+	Created  int64 `json:"created"`
+}
+
+// BillingPortalSessionRequest is used to create a BillingPortalSession
+type BillingPortalSessionRequest struct {
+	// The ID of an existing customer, set by CreateBillingPortalSession.
+	Customer string `json:"customer"`
+	// The default URL to redirect customers to when they click on the portal's link to return to your website. (Optional)
+	ReturnURL *string `json:"return_url"`
+	// The ID of an existing configuration to use for this session. (Optional)
+	Configuration *string `json:"configuration"`
+	// The IETF language tag of the locale Customer Portal is displayed in. (Optional)
+	Locale *string `json:"locale"`
+	// Information about a specific flow for the customer to go through. (Optional)
+	FlowData *BillingPortalFlowData `json:"flow_data"`
+}
+
+func (b *BillingPortalSessionRequest) ToFormValues() (form url.Values) {
+	form = make(url.Values, 2)
+	setFormString(form, "customer", b.Customer)
+	setFormStringPtr(form, "return_url", b.ReturnURL)
+	setFormStringPtr(form, "configuration", b.Configuration)
+	setFormStringPtr(form, "locale", b.Locale)
+	b.FlowData.AppendFormValues(form, "flow_data")
+	return
+}
+
+// BillingPortalFlowData represents a specific flow for the customer to go through within a BillingPortalSession
+type BillingPortalFlowData struct {
+	// Type of flow that the customer will go through, see the BillingPortalFlowType constants.
+	Type string `json:"type"`
+	// Behavior after the flow is completed. (Optional)
+	AfterCompletion *BillingPortalAfterCompletion `json:"after_completion"`
+	// Configuration when Type is subscription_cancel.
+	SubscriptionCancel *BillingPortalFlowSubscription `json:"subscription_cancel"`
+	// Configuration when Type is subscription_update.
+	SubscriptionUpdate *BillingPortalFlowSubscription `json:"subscription_update"`
+	// Configuration when Type is subscription_update_confirm.
+	SubscriptionUpdateConfirm *BillingPortalFlowSubscriptionUpdateConfirm `json:"subscription_update_confirm"`
+}
+
+func (b *BillingPortalFlowData) AppendFormValues(values url.Values, key string) {
+	if b == nil {
+		return
+	}
+
+	setFormString(values, getFieldKey(key, "type"), b.Type)
+	b.AfterCompletion.AppendFormValues(values, getFieldKey(key, "after_completion"))
+	b.SubscriptionCancel.AppendFormValues(values, getFieldKey(key, "subscription_cancel"))
+	b.SubscriptionUpdate.AppendFormValues(values, getFieldKey(key, "subscription_update"))
+	b.SubscriptionUpdateConfirm.AppendFormValues(values, getFieldKey(key, "subscription_update_confirm"))
+}
+
+// BillingPortalAfterCompletion represents the behavior after a BillingPortalFlowData is completed
+type BillingPortalAfterCompletion struct {
+	// The specified behavior after the flow is completed, one of hosted_confirmation, portal_homepage, or redirect.
+	Type string `json:"type"`
+	// Configuration when Type is hosted_confirmation. (Optional)
+	HostedConfirmation *BillingPortalHostedConfirmation `json:"hosted_confirmation"`
+	// Configuration when Type is redirect.
+	Redirect *BillingPortalRedirect `json:"redirect"`
+}
+
+func (b *BillingPortalAfterCompletion) AppendFormValues(values url.Values, key string) {
+	if b == nil {
+		return
+	}
+
+	setFormString(values, getFieldKey(key, "type"), b.Type)
+	if b.HostedConfirmation != nil {
+		setFormStringPtr(values, getFieldKey(getFieldKey(key, "hosted_confirmation"), "custom_message"), b.HostedConfirmation.CustomMessage)
+	}
+
+	if b.Redirect != nil {
+		setFormString(values, getFieldKey(getFieldKey(key, "redirect"), "return_url"), b.Redirect.ReturnURL)
+	}
+}
+
+// BillingPortalHostedConfirmation configures the confirmation page shown after a flow is completed
+type BillingPortalHostedConfirmation struct {
+	// A custom message to display to the customer after the flow is completed.
+	CustomMessage *string `json:"custom_message"`
+}
+
+// BillingPortalRedirect configures the redirect performed after a flow is completed
+type BillingPortalRedirect struct {
+	// The URL the customer will be redirected to after the flow is completed.
+	ReturnURL string `json:"return_url"`
+}
+
+// BillingPortalFlowSubscription references the subscription of a BillingPortalFlowData
+type BillingPortalFlowSubscription struct {
+	// The ID of the subscription to be canceled or updated.
+	Subscription string `json:"subscription"`
+}
+
+func (b *BillingPortalFlowSubscription) AppendFormValues(values url.Values, key string) {
+	if b == nil {
+		return
+	}
+
+	setFormString(values, getFieldKey(key, "subscription"), b.Subscription)
+}
+
+// BillingPortalFlowSubscriptionUpdateConfirm configures a flow which confirms an update to a subscription
+type BillingPortalFlowSubscriptionUpdateConfirm struct {
+	// The ID of the subscription to be updated.
+	Subscription string `json:"subscription"`
+	// The subscription items to be updated, only one item can be updated at a time.
+	Items []BillingPortalFlowItem `json:"items"`
+	// The coupon or promotion code to apply to the subscription, only one discount can be applied. (Optional)
+	Discounts []BillingPortalFlowDiscount `json:"discounts"`
+}
+
+func (b *BillingPortalFlowSubscriptionUpdateConfirm) AppendFormValues(values url.Values, key string) {
+	if b == nil {
+		return
+	}
+
+	setFormString(values, getFieldKey(key, "subscription"), b.Subscription)
+	for i, item := range b.Items {
+		itemKey := fmt.Sprintf("%s[items][%d]", key, i)
+		setFormString(values, getFieldKey(itemKey, "id"), item.ID)
+		setFormStringPtr(values, getFieldKey(itemKey, "price"), item.Price)
+		setFormInt64Ptr(values, getFieldKey(itemKey, "quantity"), item.Quantity)
+	}
+
+	for i, discount := range b.Discounts {
+		discountKey := fmt.Sprintf("%s[discounts][%d]", key, i)
+		setFormStringPtr(values, getFieldKey(discountKey, "coupon"), discount.Coupon)
+		setFormStringPtr(values, getFieldKey(discountKey, "promotion_code"), discount.PromotionCode)
+	}
+}
+
+// BillingPortalFlowItem is a subscription item updated within a BillingPortalFlowSubscriptionUpdateConfirm
+type BillingPortalFlowItem struct {
+	// The ID of the subscription item to be updated.
+	ID string `json:"id"`
+	// The price the customer should subscribe to through this flow. (Optional)
+	Price *string `json:"price"`
+	// Quantity for this item that the customer should subscribe to through this flow. (Optional)
+	Quantity *int64 `json:"quantity"`
+}
+
+// BillingPortalFlowDiscount is a discount applied within a BillingPortalFlowSubscriptionUpdateConfirm
+type BillingPortalFlowDiscount struct {
+	// The ID of a coupon to apply to this subscription update. (Optional)
+	Coupon *string `json:"coupon"`
+	// The ID of a promotion code to apply to this subscription update. (Optional)
+	PromotionCode *string `json:"promotion_code"`
+}
+
+// BillingPortalConfiguration describes the functionality and behavior of a BillingPortalSession
+type BillingPortalConfiguration struct {
+	ID     string `json:"id"`
+	Object string `json:"object"`
+
+	// Whether the configuration is active and can be used to create portal sessions.
+	Active bool `json:"active"`
+	// Whether the configuration is the default.
+	IsDefault bool `json:"is_default"`
+	// The business information shown to customers in the portal.
+	BusinessProfile BillingPortalBusinessProfile `json:"business_profile"`
+	// The default URL to redirect customers to when they click on the portal's link to return to your website.
+	DefaultReturnURL *string `json:"default_return_url"`
+	// Information about the features available in the portal.
+	Features BillingPortalFeatures `json:"features"`
+
+	Metadata Dictionary `json:"metadata"`
+	Livemode bool       `json:"livemode"`
+	Created  int64      `json:"created"`
+	Updated  int64      `json:"updated"`
+}
+
+// BillingPortalConfigurationRequest is used to create or update a BillingPortalConfiguration
+type BillingPortalConfigurationRequest struct {
+	// Whether the configuration is active and can be used to create portal sessions. Only used on update. (Optional)
+	Active *bool `json:"active"`
+	// The business information shown to customers in the portal. Required on creation.
+	BusinessProfile *BillingPortalBusinessProfile `json:"business_profile"`
+	// The default URL to redirect customers to when they click on the portal's link to return to your website. (Optional)
+	DefaultReturnURL *string `json:"default_return_url"`
+	// Information about the features available in the portal. Required on creation.
+	Features *BillingPortalFeatures `json:"features"`
+
+	Metadata Dictionary `json:"metadata"`
+}
+
+func (b *BillingPortalConfigurationRequest) ToFormValues() (form url.Values) {
+	// Pre-allocate values with enough space for the basic configuration rows
+	form = make(url.Values, 6)
+	setFormBoolPtr(form, "active", b.Active)
+	setFormStringPtr(form, "default_return_url", b.DefaultReturnURL)
+	b.BusinessProfile.AppendFormValues(form, "business_profile")
+	b.Features.AppendFormValues(form, "features")
+	b.Metadata.AppendFormValues(form, "metadata")
+	return
+}
+
+// BillingPortalBusinessProfile represents the business information shown to customers in the portal
+type BillingPortalBusinessProfile struct {
+	// The messaging shown to customers in the portal.
+	Headline *string `json:"headline"`
+	// A link to the business's publicly available privacy policy.
+	PrivacyPolicyURL *string `json:"privacy_policy_url"`
+	// A link to the business's publicly available terms of service.
+	TermsOfServiceURL *string `json:"terms_of_service_url"`
+}
+
+func (b *BillingPortalBusinessProfile) AppendFormValues(values url.Values, key string) {
+	if b == nil {
+		return
+	}
+
+	setFormStringPtr(values, getFieldKey(key, "headline"), b.Headline)
+	setFormStringPtr(values, getFieldKey(key, "privacy_policy_url"), b.PrivacyPolicyURL)
+	setFormStringPtr(values, getFieldKey(key, "terms_of_service_url"), b.TermsOfServiceURL)
+}
+
+// BillingPortalFeatures represent the features available in the portal, a nil feature is left unchanged
+type BillingPortalFeatures struct {
+	// Information about updating the customer details in the portal.
+	CustomerUpdate *BillingPortalCustomerUpdate `json:"customer_update"`
+	// Information about showing the billing history in the portal.
+	InvoiceHistory *BillingPortalFeature `json:"invoice_history"`
+	// Information about updating payment methods in the portal.
+	PaymentMethodUpdate *BillingPortalFeature `json:"payment_method_update"`
+	// Information about canceling subscriptions in the portal.
+	SubscriptionCancel *BillingPortalSubscriptionCancel `json:"subscription_cancel"`
+	// Information about updating subscriptions in the portal.
+	SubscriptionUpdate *BillingPortalSubscriptionUpdate `json:"subscription_update"`
+}
+
+func (b *BillingPortalFeatures) AppendFormValues(values url.Values, key string) {
+	if b == nil {
+		return
+	}
+
+	b.CustomerUpdate.AppendFormValues(values, getFieldKey(key, "customer_update"))
+	b.InvoiceHistory.AppendFormValues(values, getFieldKey(key, "invoice_history"))
+	b.PaymentMethodUpdate.AppendFormValues(values, getFieldKey(key, "payment_method_update"))
+	b.SubscriptionCancel.AppendFormValues(values, getFieldKey(key, "subscription_cancel"))
+	b.SubscriptionUpdate.AppendFormValues(values, getFieldKey(key, "subscription_update"))
+}
+
+// BillingPortalFeature represents a portal feature which can only be enabled or disabled
+type BillingPortalFeature struct {
+	// Whether the feature is enabled.
+	Enabled bool `json:"enabled"`
+}
+
+func (b *BillingPortalFeature) AppendFormValues(values url.Values, key string) {
+	if b == nil {
+		return
+	}
+
+	setFormBoolPtr(values, getFieldKey(key, "enabled"), &b.Enabled)
+}
+
+// BillingPortalCustomerUpdate represents the customer details update feature of the portal
+type BillingPortalCustomerUpdate struct {
+	// Whether the feature is enabled.
+	Enabled bool `json:"enabled"`
+	// The types of customer updates that are supported, any of email, address, shipping, phone, and tax_id.
+	AllowedUpdates []string `json:"allowed_updates"`
+}
+
+func (b *BillingPortalCustomerUpdate) AppendFormValues(values url.Values, key string) {
+	if b == nil {
+		return
+	}
+
+	setFormBoolPtr(values, getFieldKey(key, "enabled"), &b.Enabled)
+	setFormStringSlice(values, getFieldKey(key, "allowed_updates"), b.AllowedUpdates)
+}
+
+// BillingPortalSubscriptionCancel represents the subscription cancellation feature of the portal
+type BillingPortalSubscriptionCancel struct {
+	// Whether the feature is enabled.
+	Enabled bool `json:"enabled"`
+	// Whether to cancel subscriptions immediately or at the end of the billing period, one of at_period_end or immediately.
+	Mode *string `json:"mode"`
+	// Whether to create prorations when canceling subscriptions. Only used when Mode is immediately.
+	ProrationBehavior *string `json:"proration_behavior"`
+}
+
+func (b *BillingPortalSubscriptionCancel) AppendFormValues(values url.Values, key string) {
+	if b == nil {
+		return
+	}
+
+	setFormBoolPtr(values, getFieldKey(key, "enabled"), &b.Enabled)
+	setFormStringPtr(values, getFieldKey(key, "mode"), b.Mode)
+	setFormStringPtr(values, getFieldKey(key, "proration_behavior"), b.ProrationBehavior)
+}
+
+// BillingPortalSubscriptionUpdate represents the subscription update feature of the portal
+type BillingPortalSubscriptionUpdate struct {
+	// Whether the feature is enabled.
+	Enabled bool `json:"enabled"`
+	// The types of subscription updates that are supported, any of price, quantity, and promotion_code.
+	DefaultAllowedUpdates []string `json:"default_allowed_updates"`
+	// The list of up to 10 products that support subscription updates.
+	Products []BillingPortalProduct `json:"products"`
+	// Determines how to handle prorations resulting from subscription updates.
+	ProrationBehavior *string `json:"proration_behavior"`
+}
+
+func (b *BillingPortalSubscriptionUpdate) AppendFormValues(values url.Values, key string) {
+	if b == nil {
+		return
+	}
+
+	setFormBoolPtr(values, getFieldKey(key, "enabled"), &b.Enabled)
+	setFormStringSlice(values, getFieldKey(key, "default_allowed_updates"), b.DefaultAllowedUpdates)
+	setFormStringPtr(values, getFieldKey(key, "proration_behavior"), b.ProrationBehavior)
+	for i, product := range b.Products {
+		productKey := fmt.Sprintf("%s[%d]", getFieldKey(key, "products"), i)
+		setFormString(values, getFieldKey(productKey, "product"), product.Product)
+		setFormStringSlice(values, getFieldKey(productKey, "prices"), product.Prices)
+	}
+}
+
+// BillingPortalProduct represents a product, and it's prices, which customers can switch between in the portal
+type BillingPortalProduct struct {
+	// The product ID.
+	Product string `json:"product"`
+	// The list of price IDs which, when subscribed to, a subscription can be updated.
+	Prices []string `json:"prices"`
+}
+
+// BillingPortalConfigurationListRequest is used to list BillingPortalConfigurations
+type BillingPortalConfigurationListRequest struct {
+	ListParams
+
+	// Only return configurations that are active or inactive. (Optional)
+	Active *bool `json:"active"`
+	// Only return the default or non-default configurations. (Optional)
+	IsDefault *bool `json:"is_default"`
+}
+
+func (b *BillingPortalConfigurationListRequest) ToFormValues() (form url.Values) {
+	form = b.ListParams.ToFormValues()
+	setFormBoolPtr(form, "active", b.Active)
+	setFormBoolPtr(form, "is_default", b.IsDefault)
+	return
+}
+
+// BillingPortalConfigurationList is a paginated list of BillingPortalConfigurations
+type BillingPortalConfigurationList struct {
+	List
+	Data []BillingPortalConfiguration `json:"data"`
+}
+
+func (c *Client) CreateBillingPortalSession(stripeUserID string, request BillingPortalSessionRequest) (created BillingPortalSession, err error) {
+	request.Customer = stripeUserID
+	err = c.request("POST", endpointBillingPortalSessions, &request, &created)
+	return
+}
+
+func (c *Client) CreateBillingPortalConfiguration(request BillingPortalConfigurationRequest) (created BillingPortalConfiguration, err error) {
+	err = c.request("POST", endpointBillingPortalConfigurations, &request, &created)
+	return
+}
+
+func (c *Client) GetBillingPortalConfiguration(configurationID string) (configuration BillingPortalConfiguration, err error) {
+	err = c.request("GET", endpointBillingPortalConfigurationsWithID, nil, &configuration, configurationID)
+	return
+}
+
+func (c *Client) UpdateBillingPortalConfiguration(configurationID string, request BillingPortalConfigurationRequest) (updated BillingPortalConfiguration, err error) {
+	err = c.request("POST", endpointBillingPortalConfigurationsWithID, &request, &updated, configurationID)
+	return
+}
+
+func (c *Client) ListBillingPortalConfigurations(request BillingPortalConfigurationListRequest) (list BillingPortalConfigurationList, err error) {
+	err = c.request("GET", endpointBillingPortalConfigurations, &request, &list)
+	return
+}
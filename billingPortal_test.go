@@ -0,0 +1,61 @@
+package stripe
+
+import (
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+func TestBillingPortalSessionRequest_ToFormValues(t *testing.T) {
+	var req BillingPortalSessionRequest
+	req.Customer = "cus_123"
+	req.ReturnURL = String("https://example.com/account")
+	req.FlowData = &BillingPortalFlowData{
+		Type:               BillingPortalFlowTypeSubscriptionCancel,
+		SubscriptionCancel: &BillingPortalFlowSubscription{Subscription: "sub_123"},
+		AfterCompletion: &BillingPortalAfterCompletion{
+			Type:     BillingPortalAfterCompletionRedirect,
+			Redirect: &BillingPortalRedirect{ReturnURL: "https://example.com/cancelled"},
+		},
+	}
+
+	wanted := url.Values{
+		"customer":        {"cus_123"},
+		"return_url":      {"https://example.com/account"},
+		"flow_data[type]": {"subscription_cancel"},
+		"flow_data[subscription_cancel][subscription]":      {"sub_123"},
+		"flow_data[after_completion][type]":                 {"redirect"},
+		"flow_data[after_completion][redirect][return_url]": {"https://example.com/cancelled"},
+	}
+
+	if form := req.ToFormValues(); !reflect.DeepEqual(wanted, form) {
+		t.Fatalf("invalid form values, expected %v and received %v", wanted, form)
+	}
+}
+
+func TestBillingPortalFlowData_subscription_update_confirm(t *testing.T) {
+	var req BillingPortalSessionRequest
+	req.Customer = "cus_123"
+	req.FlowData = &BillingPortalFlowData{
+		Type: BillingPortalFlowTypeSubscriptionUpdateConfirm,
+		SubscriptionUpdateConfirm: &BillingPortalFlowSubscriptionUpdateConfirm{
+			Subscription: "sub_123",
+			Items:        []BillingPortalFlowItem{{ID: "si_123", Price: String("price_456"), Quantity: Int64(2)}},
+			Discounts:    []BillingPortalFlowDiscount{{Coupon: String("SUMMER25")}},
+		},
+	}
+
+	wanted := url.Values{
+		"customer":        {"cus_123"},
+		"flow_data[type]": {"subscription_update_confirm"},
+		"flow_data[subscription_update_confirm][subscription]":         {"sub_123"},
+		"flow_data[subscription_update_confirm][items][0][id]":         {"si_123"},
+		"flow_data[subscription_update_confirm][items][0][price]":      {"price_456"},
+		"flow_data[subscription_update_confirm][items][0][quantity]":   {"2"},
+		"flow_data[subscription_update_confirm][discounts][0][coupon]": {"SUMMER25"},
+	}
+
+	if form := req.ToFormValues(); !reflect.DeepEqual(wanted, form) {
+		t.Fatalf("invalid form values, expected %v and received %v", wanted, form)
+	}
+}
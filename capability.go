@@ -0,0 +1,64 @@
+package stripe
+
+import (
+	"net/url"
+)
+
+const (
+	CapabilityStatusActive      = "active"
+	CapabilityStatusDisabled    = "disabled"
+	CapabilityStatusInactive    = "inactive"
+	CapabilityStatusPending     = "pending"
+	CapabilityStatusUnrequested = "unrequested"
+)
+
+// Capability represents a feature of an Account, such as card_payments or transfers
+type Capability struct {
+	ID     string `json:"id"`
+	Object string `json:"object"`
+
+	// The account for which the capability enables functionality.
+	Account string `json:"account"`
+	// Whether the capability has been requested.
+	Requested bool `json:"requested"`
+	// Time at which the capability was requested.
+	RequestedAt *int64 `json:"requested_at"`
+	// The status of the capability, see the CapabilityStatus constants.
+	Status string `json:"status"`
+	// Information about the requirements for the capability.
+	Requirements *AccountRequirements `json:"requirements"`
+}
+
+// CapabilityList is a list of Capabilities
+type CapabilityList struct {
+	List
+	Data []Capability `json:"data"`
+}
+
+type capabilityRequest struct {
+	Requested bool `json:"requested"`
+}
+
+func (c *capabilityRequest) ToFormValues() (form url.Values) {
+	form = make(url.Values, 1)
+	setFormBoolPtr(form, "requested", &c.Requested)
+	return
+}
+
+func (c *Client) ListCapabilities(accountID string) (list CapabilityList, err error) {
+	err = c.request("GET", endpointCapabilitiesWithID, nil, &list, accountID)
+	return
+}
+
+func (c *Client) GetCapability(accountID, capability string) (retrieved Capability, err error) {
+	err = c.request("GET", endpointCapabilitiesWithIDAndCapability, nil, &retrieved, accountID, capability)
+	return
+}
+
+// UpdateCapability will request, or un-request, a Capability of an Account
+func (c *Client) UpdateCapability(accountID, capability string, requested bool) (updated Capability, err error) {
+	var req capabilityRequest
+	req.Requested = requested
+	err = c.request("POST", endpointCapabilitiesWithIDAndCapability, &req, &updated, accountID, capability)
+	return
+}
@@ -1,6 +1,7 @@
 package stripe
 
 import (
+	"fmt"
 	"net/url"
 )
 
@@ -48,6 +49,10 @@ type Card struct {
 	LastFour    string `json:"last4"`
 	Brand       string `json:"brand"`
 	CVCCheck    string `json:"cvc_check"`
+	// The ID of the connected account the card belongs to, only set for external accounts
+	Account *string `json:"account"`
+	// Whether the card is the default external account for its currency, only set for external accounts
+	DefaultForCurrency bool `json:"default_for_currency"`
 }
 
 func (c *Card) AppendFormValues(form url.Values, key string) {
@@ -64,3 +69,31 @@ func (c *Card) AppendFormValues(form url.Values, key string) {
 	setFormStringPtr(form, getFieldKey(key, "address_country"), c.Country)
 	setFormStringPtr(form, getFieldKey(key, "currency"), c.Currency)
 }
+
+// String returns the Card with its card number masked and CVC redacted, making it safe to log
+func (c Card) String() string {
+	return fmt.Sprintf("%+v", c.redacted())
+}
+
+// Format ensures the card number and CVC are never printed, regardless of the formatting verb
+func (c Card) Format(f fmt.State, verb rune) {
+	fmt.Fprintf(f, formatDirective(f, verb), c.redacted())
+}
+
+// Redacted returns a copy of the Card with its card number masked and CVC redacted
+// JSON encoding is left untouched, encode the redacted copy when a Card is logged as JSON
+func (c Card) Redacted() Card {
+	c.CardNumber = maskNumber(c.CardNumber)
+	if c.CVC != nil {
+		c.CVC = String(redacted)
+	}
+
+	return c
+}
+
+// redactedCard has the fields of a Card without its methods, allowing it to be printed as is
+type redactedCard Card
+
+func (c Card) redacted() redactedCard {
+	return redactedCard(c.Redacted())
+}
@@ -1,6 +1,9 @@
 package stripe
 
-import "net/url"
+import (
+	"encoding/json"
+	"net/url"
+)
 
 type Charge struct {
 	// System fields
@@ -34,6 +37,20 @@ type Charge struct {
 	Description *string `json:"description"`
 	// Custom metadata for the charge
 	Metadata Dictionary `json:"metadata"`
+
+	// Connect fields
+	// A fee in cents that will be applied to the charge and transferred to the platform account
+	ApplicationFeeAmount *int64 `json:"application_fee_amount"`
+	// The connected account the charge is made on behalf of, it becomes the settlement merchant
+	OnBehalfOf *string `json:"on_behalf_of"`
+	// Details of the Transfer to a connected account which is created automatically for a destination charge
+	TransferData *ChargeTransferData `json:"transfer_data"`
+	// A string that identifies the charge as part of a group of Transfers
+	TransferGroup *string `json:"transfer_group"`
+	// ID of the application fee created for the charge (set by Stripe)
+	ApplicationFee *string `json:"application_fee"`
+	// ID of the transfer to the destination account (set by Stripe)
+	Transfer *string `json:"transfer"`
 }
 
 func (c *Charge) ToFormValues() (form url.Values) {
@@ -44,6 +61,89 @@ func (c *Charge) ToFormValues() (form url.Values) {
 	setFormString(form, "customer", c.StripeUserID)
 	setFormString(form, "source", string(c.Source))
 	setFormStringPtr(form, "description", c.Description)
+	setFormInt64Ptr(form, "application_fee_amount", c.ApplicationFeeAmount)
+	setFormStringPtr(form, "on_behalf_of", c.OnBehalfOf)
+	setFormStringPtr(form, "transfer_group", c.TransferGroup)
+	c.TransferData.AppendFormValues(form, "transfer_data")
 	c.Metadata.AppendFormValues(form, "metadata")
 	return
 }
+
+// ChargeTransferData represents the destination of the funds of a destination charge
+type ChargeTransferData struct {
+	// ID of an existing, connected Stripe account
+	Destination string `json:"destination"`
+	// The amount to transfer to the destination account, defaults to the full charge amount (Optional)
+	Amount *int64 `json:"amount"`
+}
+
+func (c *ChargeTransferData) AppendFormValues(values url.Values, key string) {
+	if c == nil {
+		return
+	}
+
+	setFormString(values, getFieldKey(key, "destination"), c.Destination)
+	setFormInt64Ptr(values, getFieldKey(key, "amount"), c.Amount)
+}
+
+// ExpandableCharge references a Charge by ID, the Charge itself is only present when the field is expanded
+type ExpandableCharge struct {
+	ID     string
+	Charge *Charge
+}
+
+func (e *ExpandableCharge) UnmarshalJSON(bs []byte) (err error) {
+	if err = json.Unmarshal(bs, &e.ID); err == nil {
+		return
+	}
+
+	var c Charge
+	if err = json.Unmarshal(bs, &c); err != nil {
+		return
+	}
+
+	e.ID = c.ID
+	e.Charge = &c
+	return
+}
+
+// ChargeSearchResult is a paginated list of Charges matching a search query
+type ChargeSearchResult struct {
+	SearchResult
+	Data []Charge `json:"data"`
+}
+
+// ChargeIterator iterates over every Charge of a paginated list
+type ChargeIterator struct {
+	*Iterator
+}
+
+// Charge returns the Charge the iterator is currently at
+func (c *ChargeIterator) Charge() (charge Charge) {
+	charge, _ = c.Current().(Charge)
+	return
+}
+
+func (c *Client) SearchCharges(params SearchParams) (result ChargeSearchResult, err error) {
+	err = c.request("GET", endpointChargesSearch, &params, &result)
+	return
+}
+
+// IterateChargeSearch will return an iterator over every Charge matching the search query, fetching pages as needed
+func (c *Client) IterateChargeSearch(params SearchParams) *ChargeIterator {
+	fetch := func(params SearchParams) (page []interface{}, result SearchResult, err error) {
+		var resp ChargeSearchResult
+		if resp, err = c.SearchCharges(params); err != nil {
+			return
+		}
+
+		for _, charge := range resp.Data {
+			page = append(page, charge)
+		}
+
+		result = resp.SearchResult
+		return
+	}
+
+	return &ChargeIterator{newSearchIterator(params, fetch)}
+}
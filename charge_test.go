@@ -0,0 +1,45 @@
+package stripe
+
+import "testing"
+
+func TestCharge_ToFormValues_destination(t *testing.T) {
+	var charge Charge
+	charge.Amount = 1000
+	charge.Currency = "usd"
+	charge.ApplicationFeeAmount = Int64(123)
+	charge.TransferGroup = String("order_123")
+	charge.TransferData = &ChargeTransferData{Destination: "acct_123"}
+
+	form := charge.ToFormValues()
+	tcs := []struct {
+		key      string
+		expected string
+	}{
+		{key: "application_fee_amount", expected: "123"},
+		{key: "transfer_group", expected: "order_123"},
+		{key: "transfer_data[destination]", expected: "acct_123"},
+		{key: "transfer_data[amount]", expected: ""},
+		{key: "on_behalf_of", expected: ""},
+	}
+
+	for _, tc := range tcs {
+		if value := form.Get(tc.key); value != tc.expected {
+			t.Fatalf("invalid value for <%s>, expected <%s> and received <%s>", tc.key, tc.expected, value)
+		}
+	}
+}
+
+func TestRefundRequest_ToFormValues_connect(t *testing.T) {
+	var req RefundRequest
+	req.Charge = "ch_123"
+	req.ReverseTransfer = Bool(true)
+	req.RefundApplicationFee = Bool(false)
+
+	form := req.ToFormValues()
+	switch {
+	case form.Get("reverse_transfer") != "true":
+		t.Fatalf("invalid reverse transfer, expected <%s> and received <%s>", "true", form.Get("reverse_transfer"))
+	case form.Get("refund_application_fee") != "false":
+		t.Fatalf("invalid refund application fee, expected <%s> and received <%s>", "false", form.Get("refund_application_fee"))
+	}
+}
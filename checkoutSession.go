@@ -0,0 +1,192 @@
+package stripe
+
+import (
+	"net/url"
+)
+
+const (
+	CheckoutSessionModePayment      = "payment"
+	CheckoutSessionModeSetup        = "setup"
+	CheckoutSessionModeSubscription = "subscription"
+
+	CheckoutSessionStatusOpen     = "open"
+	CheckoutSessionStatusComplete = "complete"
+	CheckoutSessionStatusExpired  = "expired"
+
+	CheckoutSessionPaymentStatusPaid              = "paid"
+	CheckoutSessionPaymentStatusUnpaid            = "unpaid"
+	CheckoutSessionPaymentStatusNoPaymentRequired = "no_payment_required"
+)
+
+// CheckoutSession represents a customer's session as they pay for one-time purchases or subscriptions through hosted Checkout
+type CheckoutSession struct {
+	ID     string `json:"id"`
+	Object string `json:"object"`
+
+	// The mode of the Checkout Session, one of payment, setup, or subscription.
+	Mode string `json:"mode"`
+	// The status of the Checkout Session, one of open, complete, or expired.
+	Status *string `json:"status"`
+	// The payment status of the Checkout Session, one of paid, unpaid, or no_payment_required.
+	PaymentStatus string `json:"payment_status"`
+	// The URL to the Checkout Session. Redirect customers to this URL to take them to Checkout.
+	URL *string `json:"url"`
+
+	// The ID of the customer for this Session.
+	Customer *string `json:"customer"`
+	// If provided, this value will be used when the Customer object is created.
+	CustomerEmail *string `json:"customer_email"`
+	// The customer details including the customer's tax exempt status and the customer's tax IDs.
+	CustomerDetails *CheckoutSessionCustomerDetails `json:"customer_details"`
+	// A unique string to reference the Checkout Session. This can be a customer ID, a cart ID, or similar.
+	ClientReferenceID *string `json:"client_reference_id"`
+
+	// Three-letter ISO currency code, in lowercase.
+	Currency *string `json:"currency"`
+	// Total of all items before discounts or taxes are applied.
+	AmountSubtotal *int64 `json:"amount_subtotal"`
+	// Total of all items after discounts and taxes are applied.
+	AmountTotal *int64 `json:"amount_total"`
+	// Enables user redeemable promotion codes.
+	AllowPromotionCodes *bool `json:"allow_promotion_codes"`
+
+	// The URL the customer will be directed to after the payment or subscription creation is successful.
+	SuccessURL *string `json:"success_url"`
+	// If set, Checkout displays a back button and customers will be directed to this URL if they decide to cancel payment and return to your website.
+	CancelURL *string `json:"cancel_url"`
+
+	// The ID of the PaymentIntent for Checkout Sessions in payment mode.
+	PaymentIntent *string `json:"payment_intent"`
+	// The ID of the SetupIntent for Checkout Sessions in setup mode.
+	SetupIntent *string `json:"setup_intent"`
+	// The ID of the subscription for Checkout Sessions in subscription mode.
+	Subscription *string `json:"subscription"`
+	// ID of the invoice created by the Checkout Session, if it exists.
+	Invoice *string `json:"invoice"`
+	// The ID of the Payment Link that created this Session.
+	PaymentLink *string `json:"payment_link"`
+	// The line items purchased by the customer, only present when expanded.
+	LineItems *LineItemList `json:"line_items"`
+
+	// The timestamp at which the Checkout Session will expire.
+	ExpiresAt int64 `json:"expires_at"`
+
+	Metadata Dictionary `json:"metadata"`
+	Livemode bool       `json:"livemode"`
+	Created  int64      `json:"created"`
+}
+
+// CheckoutSessionCustomerDetails represent the details of the customer at the time of session completion
+type CheckoutSessionCustomerDetails struct {
+	Address   *Address    `json:"address"`
+	Email     *string     `json:"email"`
+	Name      *string     `json:"name"`
+	Phone     *string     `json:"phone"`
+	TaxExempt *string     `json:"tax_exempt"`
+	TaxIDs    []TaxIDData `json:"tax_ids"`
+}
+
+// CheckoutSessionRequest is used to create a CheckoutSession
+type CheckoutSessionRequest struct {
+	// The mode of the Checkout Session, one of payment, setup, or subscription.
+	Mode string `json:"mode"`
+	// A list of items the customer is purchasing. Required for payment and subscription mode.
+	LineItems []LineItemRequest `json:"line_items"`
+	// The URL to which Stripe should send customers when payment or setup is complete. (Optional)
+	SuccessURL *string `json:"success_url"`
+	// If set, Checkout displays a back button and customers will be directed to this URL if they decide to cancel payment and return to your website. (Optional)
+	CancelURL *string `json:"cancel_url"`
+
+	// ID of an existing Customer, if one exists. (Optional)
+	Customer *string `json:"customer"`
+	// If provided, this value will be used when the Customer object is created. Only one of Customer or CustomerEmail can be provided. (Optional)
+	CustomerEmail *string `json:"customer_email"`
+	// A unique string to reference the Checkout Session. (Optional)
+	ClientReferenceID *string `json:"client_reference_id"`
+	// Three-letter ISO currency code, in lowercase. Required in setup mode when PaymentMethodTypes is not set.
+	Currency *string `json:"currency"`
+	// Enables user redeemable promotion codes. (Optional)
+	AllowPromotionCodes *bool `json:"allow_promotion_codes"`
+	// A list of the types of payment methods (e.g., card) this Checkout Session can accept. (Optional)
+	PaymentMethodTypes []string `json:"payment_method_types"`
+	// The Epoch time in seconds at which the Checkout Session will expire, between 30 minutes and 24 hours after creation. (Optional)
+	ExpiresAt *int64 `json:"expires_at"`
+
+	Metadata Dictionary `json:"metadata"`
+}
+
+func (c *CheckoutSessionRequest) ToFormValues() (form url.Values) {
+	// Pre-allocate values with enough space for a single line item session
+	form = make(url.Values, 6)
+	setFormString(form, "mode", c.Mode)
+	setFormStringPtr(form, "success_url", c.SuccessURL)
+	setFormStringPtr(form, "cancel_url", c.CancelURL)
+	setFormStringPtr(form, "customer", c.Customer)
+	setFormStringPtr(form, "customer_email", c.CustomerEmail)
+	setFormStringPtr(form, "client_reference_id", c.ClientReferenceID)
+	setFormStringPtr(form, "currency", c.Currency)
+	setFormBoolPtr(form, "allow_promotion_codes", c.AllowPromotionCodes)
+	setFormStringSlice(form, "payment_method_types", c.PaymentMethodTypes)
+	setFormInt64Ptr(form, "expires_at", c.ExpiresAt)
+	appendLineItems(form, "line_items", c.LineItems)
+	c.Metadata.AppendFormValues(form, "metadata")
+	return
+}
+
+// CheckoutSessionListRequest is used to list CheckoutSessions
+type CheckoutSessionListRequest struct {
+	ListParams
+
+	// Only return the Checkout Sessions for the Customer specified. (Optional)
+	Customer *string `json:"customer"`
+	// Only return the Checkout Session for the PaymentIntent specified. (Optional)
+	PaymentIntent *string `json:"payment_intent"`
+	// Only return the Checkout Session for the subscription specified. (Optional)
+	Subscription *string `json:"subscription"`
+	// Only return the Checkout Sessions matching the given status. (Optional)
+	Status *string `json:"status"`
+	// Only return Checkout Sessions that were created during the given date interval. (Optional)
+	Created *RangeQuery `json:"created"`
+}
+
+func (c *CheckoutSessionListRequest) ToFormValues() (form url.Values) {
+	form = c.ListParams.ToFormValues()
+	setFormStringPtr(form, "customer", c.Customer)
+	setFormStringPtr(form, "payment_intent", c.PaymentIntent)
+	setFormStringPtr(form, "subscription", c.Subscription)
+	setFormStringPtr(form, "status", c.Status)
+	c.Created.AppendFormValues(form, "created")
+	return
+}
+
+// CheckoutSessionList is a paginated list of CheckoutSessions
+type CheckoutSessionList struct {
+	List
+	Data []CheckoutSession `json:"data"`
+}
+
+func (c *Client) CreateCheckoutSession(request CheckoutSessionRequest) (created CheckoutSession, err error) {
+	err = c.request("POST", endpointCheckoutSessions, &request, &created)
+	return
+}
+
+func (c *Client) GetCheckoutSession(sessionID string) (session CheckoutSession, err error) {
+	err = c.request("GET", endpointCheckoutSessionsWithID, nil, &session, sessionID)
+	return
+}
+
+// ExpireCheckoutSession will expire an open CheckoutSession, customers will no longer be able to complete it
+func (c *Client) ExpireCheckoutSession(sessionID string) (expired CheckoutSession, err error) {
+	err = c.request("POST", endpointCheckoutSessionsExpireWithID, nil, &expired, sessionID)
+	return
+}
+
+func (c *Client) ListCheckoutSessions(request CheckoutSessionListRequest) (list CheckoutSessionList, err error) {
+	err = c.request("GET", endpointCheckoutSessions, &request, &list)
+	return
+}
+
+func (c *Client) ListCheckoutSessionLineItems(sessionID string, params ListParams) (list LineItemList, err error) {
+	err = c.request("GET", endpointCheckoutSessionLineItemsWithID, &params, &list, sessionID)
+	return
+}
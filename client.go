@@ -1,11 +1,13 @@
 package stripe
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
 	"path"
+	"time"
 )
 
 var (
@@ -17,6 +19,7 @@ var (
 
 const (
 	host       = "https://api.stripe.com"
+	uploadHost = "https://files.stripe.com"
 	apiVersion = "v1"
 
 	endpointCustomers              = "/customers"
@@ -26,10 +29,110 @@ const (
 	endpointSourcesWithIDAndCardID = "/customers/%s/sources/%s"
 	endpointCharges                = "/charges"
 	endpointRefunds                = "/refunds"
+
+	endpointSubscriptionItems          = "/subscription_items"
+	endpointSubscriptionItemsWithID    = "/subscription_items/%s"
+	endpointUsageRecordsWithID         = "/subscription_items/%s/usage_records"
+	endpointUsageRecordSummariesWithID = "/subscription_items/%s/usage_record_summaries"
+	endpointBillingMeterEvents         = "/billing/meter_events"
+
+	endpointInvoices                        = "/invoices"
+	endpointInvoicesWithID                  = "/invoices/%s"
+	endpointInvoicesSearch                  = "/invoices/search"
+	endpointInvoicesUpcoming                = "/invoices/upcoming"
+	endpointInvoiceLinesWithID              = "/invoices/%s/lines"
+	endpointInvoicesFinalizeWithID          = "/invoices/%s/finalize"
+	endpointInvoicesPayWithID               = "/invoices/%s/pay"
+	endpointInvoicesSendWithID              = "/invoices/%s/send"
+	endpointInvoicesVoidWithID              = "/invoices/%s/void"
+	endpointInvoicesMarkUncollectibleWithID = "/invoices/%s/mark_uncollectible"
+	endpointInvoiceItems                    = "/invoiceitems"
+	endpointInvoiceItemsWithID              = "/invoiceitems/%s"
+
+	endpointCoupons                    = "/coupons"
+	endpointCouponsWithID              = "/coupons/%s"
+	endpointPromotionCodes             = "/promotion_codes"
+	endpointPromotionCodesWithID       = "/promotion_codes/%s"
+	endpointCustomerDiscountWithID     = "/customers/%s/discount"
+	endpointSubscriptionDiscountWithID = "/subscriptions/%s/discount"
+
+	endpointCustomerBalanceTransactionsWithID                 = "/customers/%s/balance_transactions"
+	endpointCustomerBalanceTransactionsWithIDAndTransactionID = "/customers/%s/balance_transactions/%s"
+
+	endpointTaxIDsWithID         = "/customers/%s/tax_ids"
+	endpointTaxIDsWithIDAndTaxID = "/customers/%s/tax_ids/%s"
+
+	endpointTaxRates                       = "/tax_rates"
+	endpointTaxRatesWithID                 = "/tax_rates/%s"
+	endpointTaxCalculations                = "/tax/calculations"
+	endpointTaxCalculationLineItemsWithID  = "/tax/calculations/%s/line_items"
+	endpointTaxTransactionsWithID          = "/tax/transactions/%s"
+	endpointTaxTransactionLineItemsWithID  = "/tax/transactions/%s/line_items"
+	endpointTaxTransactionsFromCalculation = "/tax/transactions/create_from_calculation"
+	endpointTaxTransactionsReversal        = "/tax/transactions/create_reversal"
+
+	endpointCheckoutSessions               = "/checkout/sessions"
+	endpointCheckoutSessionsWithID         = "/checkout/sessions/%s"
+	endpointCheckoutSessionsExpireWithID   = "/checkout/sessions/%s/expire"
+	endpointCheckoutSessionLineItemsWithID = "/checkout/sessions/%s/line_items"
+
+	endpointBillingPortalSessions             = "/billing_portal/sessions"
+	endpointBillingPortalConfigurations       = "/billing_portal/configurations"
+	endpointBillingPortalConfigurationsWithID = "/billing_portal/configurations/%s"
+
+	endpointPaymentLinks               = "/payment_links"
+	endpointPaymentLinksWithID         = "/payment_links/%s"
+	endpointPaymentLinkLineItemsWithID = "/payment_links/%s/line_items"
+
+	endpointDisputes            = "/disputes"
+	endpointDisputesWithID      = "/disputes/%s"
+	endpointDisputesCloseWithID = "/disputes/%s/close"
+
+	endpointFiles              = "/files"
+	endpointFilesWithID        = "/files/%s"
+	endpointFileContentsWithID = "/files/%s/contents"
+	endpointFileLinks          = "/file_links"
+	endpointFileLinksWithID    = "/file_links/%s"
+
+	endpointBalance                   = "/balance"
+	endpointBalanceTransactions       = "/balance_transactions"
+	endpointBalanceTransactionsWithID = "/balance_transactions/%s"
+
+	endpointPayouts              = "/payouts"
+	endpointPayoutsWithID        = "/payouts/%s"
+	endpointPayoutsCancelWithID  = "/payouts/%s/cancel"
+	endpointPayoutsReverseWithID = "/payouts/%s/reverse"
+
+	endpointAccounts                        = "/accounts"
+	endpointAccountsWithID                  = "/accounts/%s"
+	endpointAccountsRejectWithID            = "/accounts/%s/reject"
+	endpointAccountLinks                    = "/account_links"
+	endpointLoginLinksWithID                = "/accounts/%s/login_links"
+	endpointCapabilitiesWithID              = "/accounts/%s/capabilities"
+	endpointCapabilitiesWithIDAndCapability = "/accounts/%s/capabilities/%s"
+
+	endpointTransfers                            = "/transfers"
+	endpointTransfersWithID                      = "/transfers/%s"
+	endpointTransferReversalsWithID              = "/transfers/%s/reversals"
+	endpointTransferReversalsWithIDAndReversalID = "/transfers/%s/reversals/%s"
+
+	endpointApplicationFees             = "/application_fees"
+	endpointApplicationFeesWithID       = "/application_fees/%s"
+	endpointApplicationFeeRefundsWithID = "/application_fees/%s/refunds"
+
+	endpointExternalAccountsWithID                     = "/accounts/%s/external_accounts"
+	endpointExternalAccountsWithIDAndExternalAccountID = "/accounts/%s/external_accounts/%s"
+
+	endpointCustomersSearch      = "/customers/search"
+	endpointChargesSearch        = "/charges/search"
+	endpointPricesSearch         = "/prices/search"
+	endpointPaymentIntentsSearch = "/payment_intents/search"
+	endpointProductsSearch       = "/products/search"
+	endpointSubscriptionsSearch  = "/subscriptions/search"
 )
 
 // New initializes and returns a new Stripe Client
-func New(apiKey string) (client *Client, err error) {
+func New(apiKey string, opts ...Option) (client *Client, err error) {
 	if len(apiKey) == 0 {
 		err = ErrEmptyAPIKey
 		return
@@ -40,7 +143,18 @@ func New(apiKey string) (client *Client, err error) {
 		return
 	}
 
+	if c.uploadURL, err = url.Parse(uploadHost); err != nil {
+		return
+	}
+
 	c.apiKey = apiKey
+	c.retryDelay = defaultRetryDelay
+	for _, opt := range opts {
+		if err = opt(&c); err != nil {
+			return
+		}
+	}
+
 	client = &c
 	return
 }
@@ -48,8 +162,41 @@ func New(apiKey string) (client *Client, err error) {
 type Client struct {
 	hc http.Client
 	u  *url.URL
+	// URL of the separate host used for file uploads
+	uploadURL *url.URL
 
 	apiKey string
+	// ID of the connected account requests are made on behalf of, empty for the platform account
+	accountID string
+
+	middleware []Middleware
+	tracer     Tracer
+	// Number of times a failed request is retried, and the delay before the first retry
+	maxRetries int
+	retryDelay time.Duration
+	// Context of every request, set with WithContext
+	ctx context.Context
+}
+
+// ForAccount returns a copy of the Client which performs every request on behalf of a connected account
+// The account ID is sent as the Stripe-Account header and is included in any returned errors
+func (c *Client) ForAccount(accountID string) *Client {
+	clone := *c
+	clone.accountID = accountID
+	return &clone
+}
+
+// WithContext returns a copy of the Client which performs every request with the provided context
+// The context is used for cancellation and deadlines, and is the parent of any spans started by a Tracer
+func (c *Client) WithContext(ctx context.Context) *Client {
+	clone := *c
+	clone.ctx = ctx
+	return &clone
+}
+
+// AccountID returns the ID of the connected account the Client is acting on behalf of, if any
+func (c *Client) AccountID() string {
+	return c.accountID
 }
 
 func (c *Client) CreateCustomer(customer Customer) (created Customer, err error) {
@@ -58,26 +205,25 @@ func (c *Client) CreateCustomer(customer Customer) (created Customer, err error)
 }
 
 func (c *Client) GetCustomer(stripeUserID string) (customer Customer, err error) {
-	endpoint := fmt.Sprintf(endpointCustomersWithID, stripeUserID)
-	err = c.request("GET", endpoint, nil, &customer)
+	err = c.request("GET", endpointCustomersWithID, nil, &customer, stripeUserID)
 	return
 }
 
 func (c *Client) UpdateCustomer(stripeUserID string, customer Customer) (updated Customer, err error) {
-	endpoint := fmt.Sprintf(endpointCustomersWithID, stripeUserID)
-	err = c.request("POST", endpoint, &customer, &updated)
+	// Tax IDs can only be provided on creation, use CreateTaxID to add them to an existing customer
+	customer.TaxIDData = nil
+	err = c.request("POST", endpointCustomersWithID, &customer, &updated, stripeUserID)
 	return
 }
 
 func (c *Client) RemoveCustomer(stripeUserID string) (err error) {
-	endpoint := fmt.Sprintf(endpointCustomersWithID, stripeUserID)
-	err = c.request("DELETE", endpoint, nil, nil)
+	err = c.request("DELETE", endpointCustomersWithID, nil, nil, stripeUserID)
 	return
 }
 
 func (c *Client) AddCreditCard(stripeUserID string, card Card) (created Card, err error) {
 	var token Token
-	if token, err = c.createCardToken(card); err != nil {
+	if token, err = c.CreateCardToken(card); err != nil {
 		err = fmt.Errorf("error creating card token: %v", err)
 		return
 	}
@@ -85,15 +231,13 @@ func (c *Client) AddCreditCard(stripeUserID string, card Card) (created Card, er
 	var req sourceRequest
 	req.Source = token.ID
 
-	endpoint := fmt.Sprintf(endpointSourcesWithID, stripeUserID)
-	err = c.request("POST", endpoint, &req, &created)
+	err = c.request("POST", endpointSourcesWithID, &req, &created, stripeUserID)
 	return
 }
 
 func (c *Client) ListCards(stripeUserID string) (cards []Card, err error) {
 	var resp listCardsResponse
-	endpoint := fmt.Sprintf(endpointSourcesWithID, stripeUserID)
-	if err = c.request("GET", endpoint, nil, &resp); err != nil {
+	if err = c.request("GET", endpointSourcesWithID, nil, &resp, stripeUserID); err != nil {
 		return
 	}
 
@@ -102,8 +246,7 @@ func (c *Client) ListCards(stripeUserID string) (cards []Card, err error) {
 }
 
 func (c *Client) RemoveCreditCard(stripeUserID, cardID string) (err error) {
-	endpoint := fmt.Sprintf(endpointSourcesWithIDAndCardID, stripeUserID, cardID)
-	err = c.request("DELETE", endpoint, nil, nil)
+	err = c.request("DELETE", endpointSourcesWithIDAndCardID, nil, nil, stripeUserID, cardID)
 	return
 }
 
@@ -118,47 +261,206 @@ func (c *Client) CreateRefund(request RefundRequest) (refund Refund, err error)
 	return
 }
 
-func (c *Client) createCardToken(card Card) (created Token, err error) {
+// CreateCardToken will tokenize a Card, the token can be used once to add the card to a customer or account
+// Set the Currency of a debit card to add it as an ExternalAccount of a connected Account for payouts
+func (c *Client) CreateCardToken(card Card) (created Token, err error) {
 	var token Token
 	token.Card = card
 	err = c.request("POST", endpointTokens, &token, &created)
 	return
 }
 
-func (c *Client) request(method, endpoint string, request Request, response interface{}) (err error) {
+// request will perform a request to an endpoint constant, any %s verbs of the endpoint are replaced by the provided IDs
+// The unformatted endpoint is used as the Route of the request, so requests can be grouped without guessing at IDs
+func (c *Client) request(method, endpoint string, request Request, response interface{}, ids ...string) (err error) {
 	var req *http.Request
-	body := getRequestBody(request)
-	url := c.getURL(method, endpoint)
-	if req, err = http.NewRequest(method, url, body); err != nil {
+	body := getRequestBody(method, request)
+	u := c.getURL(method, formatEndpoint(endpoint, ids), request)
+	if req, err = http.NewRequestWithContext(c.context(), method, u, body); err != nil {
 		err = fmt.Errorf("error creating request: %v", err)
 		return
 	}
 
-	req.Header.Set("Authorization", "Bearer "+c.apiKey)
 	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	if r, ok := request.(idempotentRequest); ok && len(r.idempotencyKey()) > 0 {
+		req.Header.Set("Idempotency-Key", r.idempotencyKey())
+	} else if method == "POST" && c.maxRetries > 0 {
+		// POST requests are only retried with an idempotency key, so the same request is never applied twice
+		var key string
+		if key, err = newIdempotencyKey(); err != nil {
+			return
+		}
+
+		req.Header.Set("Idempotency-Key", key)
+	}
+
+	var form url.Values
+	if request != nil && len(c.middleware) > 0 {
+		form = request.ToFormValues()
+	}
+
+	return c.do(req, endpoint, form, response)
+}
+
+func (c *Client) upload(endpoint string, request multipartRequest, response interface{}) (err error) {
+	body, contentType := getMultipartBody(request)
+	u := *c.uploadURL
+	u.Path = path.Join(apiVersion, endpoint)
+
+	var req *http.Request
+	if req, err = http.NewRequestWithContext(c.context(), "POST", u.String(), body); err != nil {
+		// The body will never be read, closing it stops the multipart writer
+		_ = body.Close()
+		err = fmt.Errorf("error creating request: %v", err)
+		return
+	}
+
+	req.Header.Set("Content-Type", contentType)
+	return c.do(req, endpoint, nil, response)
+}
+
+func (c *Client) do(req *http.Request, endpoint string, form url.Values, response interface{}) (err error) {
+	var (
+		resp *http.Response
+		info ResponseInfo
+	)
+
+	if resp, info, err = c.send(req, endpoint, form); err != nil {
+		return
+	}
+	defer resp.Body.Close()
+
+	if err = handleResponse(resp.Body, response); err != nil {
+		// AfterResponse has already been called for the response, so only OnError is left to inform
+		c.onError(info, err)
+	}
+
+	return
+}
+
+// send will perform the request and return the response when a 200 status code is encountered
+// Requests which fail with a retryable error are retried up to the maximum set with WithMaxRetries
+// The unformatted endpoint and form values of the request are only used to inform any Middleware and Tracer
+// Note: The caller is responsible for closing the response body
+func (c *Client) send(req *http.Request, endpoint string, form url.Values) (resp *http.Response, info ResponseInfo, err error) {
+	req.Header.Set("Authorization", "Bearer "+c.apiKey)
+	if len(c.accountID) > 0 {
+		req.Header.Set("Stripe-Account", c.accountID)
+	}
+
+	for attempt := 1; ; attempt++ {
+		var retry bool
+		if resp, info, retry, err = c.sendAttempt(req, endpoint, form, attempt); !retry || attempt > c.maxRetries {
+			return
+		}
+
+		if err = c.waitToRetry(req.Context(), attempt); err != nil {
+			return
+		}
+
+		if req, err = rewindRequest(req); err != nil {
+			return
+		}
+	}
+}
+
+// sendAttempt will perform a single attempt of a request, retry is true when the attempt failed and can be retried
+func (c *Client) sendAttempt(req *http.Request, endpoint string, form url.Values, attempt int) (resp *http.Response, info ResponseInfo, retry bool, err error) {
+	info.RequestInfo = newRequestInfo(req, endpoint, form, c.accountID, attempt)
+
+	var span Span
+	if c.tracer != nil {
+		var ctx context.Context
+		ctx, span = c.tracer.StartSpan(req.Context(), info.RequestInfo)
+		req = req.WithContext(ctx)
+	}
+
+	c.beforeRequest(info.RequestInfo)
+
+	start := time.Now()
+	defer func() {
+		info.Latency = time.Since(start)
+		c.afterResponse(info, err)
+		if span != nil {
+			span.End(info, err)
+		}
+	}()
 
-	var resp *http.Response
 	if resp, err = c.hc.Do(req); err != nil {
-		err = fmt.Errorf("error performing request: %v", err)
+		// Connection errors are retried, unless the context has been canceled
+		retry = canRetry(req) && req.Context().Err() == nil
+		err = c.wrapError(fmt.Errorf("error performing request: %w", err))
+		return
+	}
+
+	info.StatusCode = resp.StatusCode
+	info.RequestID = resp.Header.Get("Request-Id")
+	if resp.StatusCode == 200 {
 		return
 	}
 	defer resp.Body.Close()
 
+	retry = canRetry(req) && shouldRetry(resp)
 	switch resp.StatusCode {
-	case 200:
-		return handleResponse(resp.Body, response)
-	case 400, 404:
-		return handleError(resp.Body)
+	case 400, 402, 404:
+		// 402 is returned for card errors, such as a declined card
+		err = handleError(resp.Body)
 	case 401:
-		return ErrUnauthorized
+		err = ErrUnauthorized
 
 	default:
-		return fmt.Errorf("unexpected status code of: %d (url: <%s>, method: <%s>)", resp.StatusCode, url, method)
+		err = fmt.Errorf("unexpected status code of: %d (url: <%s>, method: <%s>)", resp.StatusCode, req.URL, req.Method)
+	}
+
+	err = c.wrapError(err)
+	resp = nil
+	return
+}
+
+// context returns the context of the Client, defaulting to context.Background
+func (c *Client) context() context.Context {
+	if c.ctx == nil {
+		return context.Background()
 	}
+
+	return c.ctx
 }
 
-func (c *Client) getURL(method, endpoint string) string {
+// wrapError will include the connected account ID within an error, errors are left untouched for the platform account
+// ErrUnauthorized is always returned as is, so it can still be compared directly. Use AccountID to determine the account.
+func (c *Client) wrapError(err error) error {
+	if len(c.accountID) == 0 || err == ErrUnauthorized {
+		return err
+	}
+
+	if e, ok := err.(*Error); ok {
+		e.Account = c.accountID
+		return e
+	}
+
+	return fmt.Errorf("%w (account: <%s>)", err, c.accountID)
+}
+
+// formatEndpoint replaces the %s verbs of an endpoint constant with the provided IDs
+func formatEndpoint(endpoint string, ids []string) string {
+	if len(ids) == 0 {
+		return endpoint
+	}
+
+	args := make([]interface{}, len(ids))
+	for i, id := range ids {
+		args[i] = id
+	}
+
+	return fmt.Sprintf(endpoint, args...)
+}
+
+func (c *Client) getURL(method, endpoint string, request Request) string {
 	u := *c.u
 	u.Path = path.Join(apiVersion, endpoint)
+	if request != nil && !hasRequestBody(method) {
+		u.RawQuery = request.ToFormValues().Encode()
+	}
+
 	return u.String()
 }
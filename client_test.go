@@ -3,12 +3,22 @@ package stripe
 import (
 	"fmt"
 	"log"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"os"
+	"path/filepath"
 	"reflect"
+	"sync"
 	"testing"
 	"time"
+
+	"github.com/luxraise/stripe/stripetest"
 )
 
+// testExpirationYear is fixed so recorded requests stay the same from one year to the next
+const testExpirationYear = 2099
+
 var (
 	testAPIKey = os.Getenv("STRIPE_TEST_API_KEY")
 	testClient *Client
@@ -25,12 +35,10 @@ func TestClient_customer_cycle(t *testing.T) {
 		t.Fatalf("invalid error, expected %v and recieved %v", ErrEmptyAPIKey, err)
 	}
 
-	if c, err = New(testAPIKey); err != nil {
-		t.Fatal(err)
-	}
+	c = newTestClient(t)
 
 	var customer Customer
-	name := fmt.Sprintf("Test %d", time.Now().Unix())
+	name := testCustomerName(t)
 	customer.Name = &name
 	customer.Metadata = Dictionary{"foo": "bar"}
 	customer.PreferredLocales = []string{"en-US"}
@@ -65,7 +73,7 @@ func TestClient_customer_cycle(t *testing.T) {
 
 	// Create copy of retrieved valued
 	edited := retrieved
-	editedName := fmt.Sprintf("Test %d", time.Now().Unix())
+	editedName := testCustomerName(t) + " (edited)"
 	edited.Name = &editedName
 
 	var updated Customer
@@ -100,12 +108,10 @@ func TestClient_credit_card_cycle(t *testing.T) {
 		err error
 	)
 
-	if c, err = New(testAPIKey); err != nil {
-		t.Fatal(err)
-	}
+	c = newTestClient(t)
 
 	var customer Customer
-	name := fmt.Sprintf("Test %d", time.Now().Unix())
+	name := testCustomerName(t)
 	customer.Name = &name
 
 	var created Customer
@@ -123,7 +129,7 @@ func TestClient_credit_card_cycle(t *testing.T) {
 	card.CardNumber = "4242424242424242"
 	card.CVC = String("123")
 	card.ExpirationMonth = 11
-	card.ExpirationYear = 2026
+	card.ExpirationYear = testExpirationYear
 
 	var createdCard Card
 	if createdCard, err = c.AddCreditCard(created.ID, card); err != nil {
@@ -170,12 +176,10 @@ func TestClient_CreateCharge(t *testing.T) {
 		err error
 	)
 
-	if c, err = New(testAPIKey); err != nil {
-		t.Fatal(err)
-	}
+	c = newTestClient(t)
 
 	var customer Customer
-	name := fmt.Sprintf("Test %d", time.Now().Unix())
+	name := testCustomerName(t)
 	customer.Name = &name
 
 	var created Customer
@@ -188,7 +192,7 @@ func TestClient_CreateCharge(t *testing.T) {
 	card.CardNumber = "4242424242424242"
 	card.CVC = String("123")
 	card.ExpirationMonth = 11
-	card.ExpirationYear = 2026
+	card.ExpirationYear = testExpirationYear
 
 	var createdCard Card
 	if createdCard, err = c.AddCreditCard(created.ID, card); err != nil {
@@ -223,12 +227,10 @@ func TestClient_CreateRefund(t *testing.T) {
 		err error
 	)
 
-	if c, err = New(testAPIKey); err != nil {
-		t.Fatal(err)
-	}
+	c = newTestClient(t)
 
 	var customer Customer
-	name := fmt.Sprintf("Test %d", time.Now().Unix())
+	name := testCustomerName(t)
 	customer.Name = &name
 
 	var created Customer
@@ -241,7 +243,7 @@ func TestClient_CreateRefund(t *testing.T) {
 	card.CardNumber = "4242424242424242"
 	card.CVC = String("123")
 	card.ExpirationMonth = 11
-	card.ExpirationYear = 2026
+	card.ExpirationYear = testExpirationYear
 
 	var createdCard Card
 	if createdCard, err = c.AddCreditCard(created.ID, card); err != nil {
@@ -272,6 +274,287 @@ func TestClient_CreateRefund(t *testing.T) {
 	}
 }
 
+func TestClient_ForAccount(t *testing.T) {
+	var accounts []string
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		accounts = append(accounts, r.Header.Get("Stripe-Account"))
+		w.WriteHeader(404)
+		_, _ = w.Write([]byte(`{"error":{"type":"invalid_request_error","message":"No such customer: 'cus_123'"}}`))
+	}))
+	defer s.Close()
+
+	c := newTestServerClient(t, s.URL)
+	connected := c.ForAccount("acct_123")
+
+	wanted := "No such customer: 'cus_123' (account: <acct_123>)"
+	if _, err := connected.GetCustomer("cus_123"); err == nil || err.Error() != wanted {
+		t.Fatalf("invalid error, expected <%s> and received <%v>", wanted, err)
+	}
+
+	wanted = "No such customer: 'cus_123'"
+	if _, err := c.GetCustomer("cus_123"); err == nil || err.Error() != wanted {
+		t.Fatalf("invalid error, expected <%s> and received <%v>", wanted, err)
+	}
+
+	switch {
+	case accounts[0] != "acct_123":
+		t.Fatalf("invalid Stripe-Account header, expected <%s> and received <%s>", "acct_123", accounts[0])
+	case accounts[1] != "":
+		t.Fatalf("invalid Stripe-Account header, expected no value and received <%s>", accounts[1])
+	case connected.AccountID() != "acct_123":
+		t.Fatalf("invalid account ID, expected <%s> and received <%s>", "acct_123", connected.AccountID())
+	}
+}
+
+func TestClient_ForAccount_unauthorized(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(401)
+	}))
+	defer s.Close()
+
+	connected := newTestServerClient(t, s.URL).ForAccount("acct_123")
+	if _, err := connected.GetCustomer("cus_123"); err != ErrUnauthorized {
+		t.Fatalf("invalid error, expected <%v> and received <%v>", ErrUnauthorized, err)
+	}
+}
+
+func TestClient_declined_card(t *testing.T) {
+	c := newTestClient(t)
+
+	var customer Customer
+	customer.Name = String(testCustomerName(t))
+
+	created, err := c.CreateCustomer(customer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = c.RemoveCustomer(created.ID) }()
+
+	var card Card
+	card.CardNumber = "4000000000000002"
+	card.CVC = String("123")
+	card.ExpirationMonth = 11
+	card.ExpirationYear = testExpirationYear
+
+	_, err = c.AddCreditCard(created.ID, card)
+	stripeErr, ok := err.(*Error)
+	switch {
+	case !ok:
+		t.Fatalf("invalid error, expected a card error and received <%v>", err)
+	case stripeErr.Type != "card_error":
+		t.Fatalf("invalid error type, expected <%s> and received <%s>", "card_error", stripeErr.Type)
+	case stripeErr.Code != "card_declined":
+		t.Fatalf("invalid error code, expected <%s> and received <%s>", "card_declined", stripeErr.Code)
+	case stripeErr.DeclineCode != "generic_decline":
+		t.Fatalf("invalid decline code, expected <%s> and received <%s>", "generic_decline", stripeErr.DeclineCode)
+	}
+}
+
+func TestClient_CreateRefund_exceeds_charge(t *testing.T) {
+	c := newTestClient(t)
+
+	var customer Customer
+	customer.Name = String(testCustomerName(t))
+
+	created, err := c.CreateCustomer(customer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = c.RemoveCustomer(created.ID) }()
+
+	var card Card
+	card.CardNumber = "4242424242424242"
+	card.CVC = String("123")
+	card.ExpirationMonth = 11
+	card.ExpirationYear = testExpirationYear
+
+	var createdCard Card
+	if createdCard, err = c.AddCreditCard(created.ID, card); err != nil {
+		t.Fatal(err)
+	}
+
+	var charge Charge
+	charge.Amount = 1337
+	charge.Source = Source(createdCard.ID)
+	charge.Currency = "usd"
+
+	var createdCharge Charge
+	if createdCharge, err = c.CreateCharge(created.ID, charge); err != nil {
+		t.Fatal(err)
+	}
+
+	var req RefundRequest
+	req.Charge = createdCharge.ID
+	req.Amount = createdCharge.Amount + 1
+
+	_, err = c.CreateRefund(req)
+	if stripeErr, ok := err.(*Error); !ok || stripeErr.Type != "invalid_request_error" {
+		t.Fatalf("invalid error, expected an invalid request error and received <%v>", err)
+	}
+}
+
+func TestClient_WithTransport(t *testing.T) {
+	s := stripetest.NewServer()
+	path := filepath.Join(t.TempDir(), "cassette.json")
+
+	recorder, err := stripetest.NewCassette(path, stripetest.ModeRecord)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c, err := New(stripetest.APIKey, WithHost(s.URL), WithTransport(recorder))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var customer Customer
+	customer.Name = String("Leeroy Jenkins")
+
+	var created Customer
+	if created, err = c.CreateCustomer(customer); err != nil {
+		t.Fatal(err)
+	}
+
+	s.Close()
+	if err = recorder.Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	player, err := stripetest.NewCassette(path, stripetest.ModeReplayStrict)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if c, err = New(stripetest.APIKey, WithHost(s.URL), WithTransport(player)); err != nil {
+		t.Fatal(err)
+	}
+
+	var replayed Customer
+	if replayed, err = c.CreateCustomer(customer); err != nil {
+		t.Fatal(err)
+	}
+
+	if replayed.ID != created.ID {
+		t.Fatalf("invalid ID, expected <%s> and received <%s>", created.ID, replayed.ID)
+	}
+}
+
+// testCustomerName returns a customer name which is stable across runs, so requests can be replayed from cassettes
+func testCustomerName(t *testing.T) string {
+	return "Test " + t.Name()
+}
+
+// newTestClient returns a Client for the Stripe test mode when STRIPE_TEST_API_KEY is set
+// Setting STRIPE_RECORD as well will record every interaction to a cassette within testdata/cassettes
+// Without an API key, the recorded cassette of the test is replayed when one exists
+// Otherwise the Client is pointed at an in-process fake server, allowing the tests to run offline
+func newTestClient(t *testing.T) *Client {
+	var (
+		c   *Client
+		err error
+	)
+
+	path := filepath.Join("testdata", "cassettes", t.Name()+".json")
+	_, statErr := os.Stat(path)
+
+	switch {
+	case len(testAPIKey) > 0 && len(os.Getenv("STRIPE_RECORD")) > 0:
+		var cassette *stripetest.Cassette
+		if cassette, err = stripetest.NewCassette(path, stripetest.ModeRecord); err != nil {
+			t.Fatal(err)
+		}
+
+		t.Cleanup(func() {
+			if err := cassette.Save(); err != nil {
+				t.Error(err)
+			}
+		})
+
+		c, err = New(testAPIKey, WithTransport(cassette))
+	case len(testAPIKey) > 0:
+		c, err = New(testAPIKey)
+	case len(os.Getenv("STRIPE_RECORD")) > 0:
+		// Without an API key, fixtures are recorded against the fake server
+		var cassette *stripetest.Cassette
+		if cassette, err = stripetest.NewCassette(path, stripetest.ModeRecord); err != nil {
+			t.Fatal(err)
+		}
+
+		s := stripetest.NewServer()
+		t.Cleanup(func() {
+			s.Close()
+			if err := cassette.Save(); err != nil {
+				t.Error(err)
+			}
+		})
+
+		c, err = New(stripetest.APIKey, WithHost(s.URL), WithTransport(cassette))
+	case statErr == nil:
+		// Tests send the same requests on every run, so they must match the recorded requests exactly
+		var cassette *stripetest.Cassette
+		if cassette, err = stripetest.NewCassette(path, stripetest.ModeReplayStrict); err != nil {
+			t.Fatal(err)
+		}
+
+		c, err = New(stripetest.APIKey, WithTransport(cassette))
+	default:
+		s := stripetest.NewServer()
+		t.Cleanup(s.Close)
+		c, err = New(stripetest.APIKey, WithHost(s.URL))
+	}
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return c
+}
+
+func newTestServerClient(t *testing.T, host string) *Client {
+	c, err := New("sk_test_123")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if c.u, err = url.Parse(host); err != nil {
+		t.Fatal(err)
+	}
+
+	c.uploadURL = c.u
+	return c
+}
+
+func timeNow() int64 {
+	return time.Now().Unix()
+}
+
+// newFormServer returns a test server which responds with the provided body and records the form of the last request
+func newFormServer(t *testing.T, response string) (s *httptest.Server, form func() url.Values) {
+	var (
+		mux  sync.Mutex
+		last url.Values
+	)
+
+	s = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mux.Lock()
+		defer mux.Unlock()
+		if err := r.ParseForm(); err != nil {
+			t.Error(err)
+		}
+
+		last = r.Form
+		_, _ = w.Write([]byte(response))
+	}))
+
+	form = func() url.Values {
+		mux.Lock()
+		defer mux.Unlock()
+		return last
+	}
+
+	return
+}
+
 func ExampleNew() {
 	var err error
 	if testClient, err = New("[Stripe API Key]"); err != nil {
@@ -397,3 +680,68 @@ func ExampleClient_CreateRefund() {
 
 	fmt.Printf("Stripe Refund has been created! %v\n", refund)
 }
+
+func ExampleNewUsageReporter() {
+	u := NewUsageReporter(testClient, time.Minute)
+	if err := u.Report("[Stripe Subscription Item ID]", 1); err != nil {
+		log.Fatal(err)
+	}
+
+	// Flush any remaining usage on shutdown
+	if err := u.Close(); err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Printf("Stripe usage has been reported!\n")
+}
+
+func ExampleClient_IteratePayouts() {
+	var req PayoutListRequest
+	req.Status = String(PayoutStatusPaid)
+
+	it := testClient.IteratePayouts(req)
+	for it.Next() {
+		payout := it.Payout()
+		fmt.Printf("Stripe Payout has been listed! %v\n", payout)
+	}
+
+	if err := it.Err(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func ExampleClient_ForAccount() {
+	var (
+		customer Customer
+		created  Customer
+		err      error
+	)
+
+	customer.Name = String("Leeroy Jenkins")
+
+	connected := testClient.ForAccount("[Stripe Connected Account ID]")
+	if created, err = connected.CreateCustomer(customer); err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Printf("Stripe Customer has been created for the connected account! %v\n", created)
+}
+
+func ExampleClient_IterateCustomerSearch() {
+	var params SearchParams
+	params.Query = SearchAnd(
+		SearchField("email", "leeroy@example.com"),
+		SearchMetadata("order", "123"),
+		SearchNot(SearchGreaterThan("created", 1600000000)),
+	)
+
+	it := testClient.IterateCustomerSearch(params)
+	for it.Next() {
+		customer := it.Customer()
+		fmt.Printf("Stripe Customer has been found! %v\n", customer)
+	}
+
+	if err := it.Err(); err != nil {
+		log.Fatal(err)
+	}
+}
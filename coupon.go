@@ -0,0 +1,138 @@
+package stripe
+
+import (
+	"net/url"
+)
+
+const (
+	CouponDurationOnce      = "once"
+	CouponDurationRepeating = "repeating"
+	CouponDurationForever   = "forever"
+)
+
+// Coupon represents a discount which can be applied to customers, subscriptions, invoices or checkout sessions
+type Coupon struct {
+	ID     string `json:"id"`
+	Object string `json:"object"`
+
+	// Name of the coupon displayed to customers on for instance invoices or receipts.
+	Name *string `json:"name"`
+	// Amount (in the currency specified) that will be taken off the subtotal of any invoices for this customer.
+	AmountOff *int64 `json:"amount_off"`
+	// If amount_off has been set, the three-letter ISO code for the currency of the amount to take off.
+	Currency *string `json:"currency"`
+	// Percent that will be taken off the subtotal of any invoices for this customer for the duration of the coupon.
+	PercentOff *float64 `json:"percent_off"`
+	// One of forever, once, and repeating. Describes how long a customer who applies this coupon will get the discount.
+	Duration string `json:"duration"`
+	// If duration is repeating, the number of months the coupon applies.
+	DurationInMonths *int64 `json:"duration_in_months"`
+	// Contains information about what this coupon applies to.
+	AppliesTo *CouponAppliesTo `json:"applies_to"`
+	// Maximum number of times this coupon can be redeemed, in total, across all customers, before it is no longer valid.
+	MaxRedemptions *int64 `json:"max_redemptions"`
+	// Date after which the coupon can no longer be redeemed.
+	RedeemBy *int64 `json:"redeem_by"`
+	// Number of times this coupon has been applied to a customer.
+	TimesRedeemed int64 `json:"times_redeemed"`
+	// Taking account of the above properties, whether this coupon can still be applied to a customer.
+	Valid bool `json:"valid"`
+
+	Metadata Dictionary `json:"metadata"`
+	Livemode bool       `json:"livemode"`
+	Created  int64      `json:"created"`
+}
+
+// CouponAppliesTo represents the products a Coupon is restricted to
+type CouponAppliesTo struct {
+	// A list of product IDs this coupon applies to
+	Products []string `json:"products"`
+}
+
+func (c *CouponAppliesTo) AppendFormValues(values url.Values, key string) {
+	if c == nil {
+		return
+	}
+
+	setFormStringSlice(values, getFieldKey(key, "products"), c.Products)
+}
+
+// CouponRequest is used to create or update a Coupon
+// Only Name and Metadata can be changed once a Coupon has been created
+type CouponRequest struct {
+	// Unique string of your choice that will be used to identify this coupon when applying it to a customer. If you don't want to specify a particular code, you can leave the ID blank and we'll generate a random code for you. (Optional)
+	ID *string `json:"id"`
+	// Name of the coupon displayed to customers on, for instance invoices, or receipts. (Optional)
+	Name *string `json:"name"`
+	// A positive integer representing the amount to subtract from an invoice total. Required if percent_off is not passed.
+	AmountOff *int64 `json:"amount_off"`
+	// Three-letter ISO code for the currency of the amount_off parameter. Required if amount_off is passed.
+	Currency *string `json:"currency"`
+	// A positive float larger than 0, and smaller or equal to 100, that represents the discount the coupon will apply. Required if amount_off is not passed.
+	PercentOff *float64 `json:"percent_off"`
+	// Specifies how long the discount will be in effect if used on a subscription. Defaults to once. (Optional)
+	Duration *string `json:"duration"`
+	// Required only if duration is repeating, in which case it must be a positive integer that specifies the number of months the discount will be in effect.
+	DurationInMonths *int64 `json:"duration_in_months"`
+	// A hash containing directions for what this Coupon will apply discounts to. (Optional)
+	AppliesTo *CouponAppliesTo `json:"applies_to"`
+	// A positive integer specifying the number of times the coupon can be redeemed before it's no longer valid. (Optional)
+	MaxRedemptions *int64 `json:"max_redemptions"`
+	// Unix timestamp specifying the last time at which the coupon can be redeemed. (Optional)
+	RedeemBy *int64 `json:"redeem_by"`
+
+	Metadata Dictionary `json:"metadata"`
+}
+
+func (c *CouponRequest) ToFormValues() (form url.Values) {
+	// Pre-allocate values with enough space for the basic coupon rows
+	form = make(url.Values, 4)
+	setFormStringPtr(form, "id", c.ID)
+	setFormStringPtr(form, "name", c.Name)
+	setFormInt64Ptr(form, "amount_off", c.AmountOff)
+	setFormStringPtr(form, "currency", c.Currency)
+	setFormFloat64Ptr(form, "percent_off", c.PercentOff)
+	setFormStringPtr(form, "duration", c.Duration)
+	setFormInt64Ptr(form, "duration_in_months", c.DurationInMonths)
+	setFormInt64Ptr(form, "max_redemptions", c.MaxRedemptions)
+	setFormInt64Ptr(form, "redeem_by", c.RedeemBy)
+	c.AppliesTo.AppendFormValues(form, "applies_to")
+	c.Metadata.AppendFormValues(form, "metadata")
+	return
+}
+
+// CouponList is a paginated list of Coupons
+type CouponList struct {
+	List
+	Data []Coupon `json:"data"`
+}
+
+func (c *Client) CreateCoupon(request CouponRequest) (created Coupon, err error) {
+	err = c.request("POST", endpointCoupons, &request, &created)
+	return
+}
+
+func (c *Client) GetCoupon(couponID string) (coupon Coupon, err error) {
+	err = c.request("GET", endpointCouponsWithID, nil, &coupon, couponID)
+	return
+}
+
+func (c *Client) UpdateCoupon(couponID string, request CouponRequest) (updated Coupon, err error) {
+	// Only the name and metadata of a coupon can be updated
+	var req CouponRequest
+	req.Name = request.Name
+	req.Metadata = request.Metadata
+
+	err = c.request("POST", endpointCouponsWithID, &req, &updated, couponID)
+	return
+}
+
+func (c *Client) RemoveCoupon(couponID string) (err error) {
+	err = c.request("DELETE", endpointCouponsWithID, nil, nil, couponID)
+	return
+}
+
+func (c *Client) ListCoupons(params ListParams) (list CouponList, err error) {
+	err = c.request("GET", endpointCoupons, &params, &list)
+	return
+}
@@ -4,29 +4,39 @@ import (
 	"net/url"
 )
 
+const (
+	TaxExemptNone    = "none"
+	TaxExemptExempt  = "exempt"
+	TaxExemptReverse = "reverse"
+)
+
 // Customer represents a customer
 type Customer struct {
 	ID     string `json:"id,omitempty"`
 	Object string `json:"object,omitempty"`
 
-	Name          *string `json:"name,omitempty"`
-	Description   *string `json:"description,omitempty"`
-	Discount      *string `json:"discount,omitempty"`
-	Email         *string `json:"email,omitempty"`
-	DefaultSource *string `json:"default_source,omitempty"`
-	Phone         *string `json:"phone,omitempty"`
+	Name          *string   `json:"name,omitempty"`
+	Description   *string   `json:"description,omitempty"`
+	Discount      *Discount `json:"discount,omitempty"`
+	Email         *string   `json:"email,omitempty"`
+	DefaultSource *string   `json:"default_source,omitempty"`
+	Phone         *string   `json:"phone,omitempty"`
 
 	Metadata Dictionary `json:"metadata,omitempty"`
 	Address  *Address   `json:"address,omitempty"`
 
-	Balance  int64  `json:"balance,omitempty"`
+	// Balance is only sent when set, a nil value will leave the current balance untouched on update
+	Balance  *int64 `json:"balance,omitempty"`
 	Currency string `json:"currency,omitempty"`
 
 	InvoicePrefix       *string          `json:"invoice_prefix,omitempty"`
 	InvoiceSettings     *InvoiceSettings `json:"invoice_settings,omitempty"`
 	NextInvoiceSequence *int64           `json:"next_invoice_sequence,omitempty"`
 
+	// The customer's tax exemption, one of none, exempt, or reverse
 	TaxExempt *string `json:"tax_exempt,omitempty"`
+	// The customer's tax IDs, only used on create
+	TaxIDData []TaxIDData `json:"tax_id_data,omitempty"`
 
 	Livemode   *bool `json:"livemode,omitempty"`
 	Delinquent *bool `json:"delinquent,omitempty"`
@@ -35,6 +45,11 @@ type Customer struct {
 	Shipping         Dictionary `json:"shipping,omitempty"`
 
 	Created int64 `json:"created,omitempty"`
+
+	// The ID of a coupon to apply to the customer, only used on create and update
+	Coupon *string `json:"coupon,omitempty"`
+	// The ID of a promotion code to apply to the customer, only used on create and update
+	PromotionCode *string `json:"promotion_code,omitempty"`
 }
 
 func (c *Customer) ToFormValues() (form url.Values) {
@@ -42,16 +57,18 @@ func (c *Customer) ToFormValues() (form url.Values) {
 	form = make(url.Values, 6)
 	setFormStringPtr(form, "name", c.Name)
 	setFormStringPtr(form, "description", c.Description)
-	setFormStringPtr(form, "discount", c.Discount)
 	setFormStringPtr(form, "email", c.Email)
 	setFormStringPtr(form, "default_source", c.DefaultSource)
 	setFormStringPtr(form, "phone", c.Phone)
-	setFormInt64(form, "balance", c.Balance)
+	setFormInt64Ptr(form, "balance", c.Balance)
 	setFormString(form, "currency", c.Currency)
 	setFormStringPtr(form, "invoice_prefix", c.InvoicePrefix)
 	setFormInt64Ptr(form, "next_invoice_sequence", c.NextInvoiceSequence)
 	setFormStringPtr(form, "tax_exempt", c.TaxExempt)
 	setFormStringSlice(form, "preferred_locales", c.PreferredLocales)
+	appendTaxIDData(form, "tax_id_data", c.TaxIDData)
+	setFormStringPtr(form, "coupon", c.Coupon)
+	setFormStringPtr(form, "promotion_code", c.PromotionCode)
 
 	c.Metadata.AppendFormValues(form, "metadata")
 	c.Address.AppendFormValues(form, "address")
@@ -59,3 +76,44 @@ func (c *Customer) ToFormValues() (form url.Values) {
 	c.Shipping.AppendFormValues(form, "shipping")
 	return
 }
+
+// CustomerSearchResult is a paginated list of Customers matching a search query
+type CustomerSearchResult struct {
+	SearchResult
+	Data []Customer `json:"data"`
+}
+
+// CustomerIterator iterates over every Customer of a paginated list
+type CustomerIterator struct {
+	*Iterator
+}
+
+// Customer returns the Customer the iterator is currently at
+func (c *CustomerIterator) Customer() (customer Customer) {
+	customer, _ = c.Current().(Customer)
+	return
+}
+
+func (c *Client) SearchCustomers(params SearchParams) (result CustomerSearchResult, err error) {
+	err = c.request("GET", endpointCustomersSearch, &params, &result)
+	return
+}
+
+// IterateCustomerSearch will return an iterator over every Customer matching the search query, fetching pages as needed
+func (c *Client) IterateCustomerSearch(params SearchParams) *CustomerIterator {
+	fetch := func(params SearchParams) (page []interface{}, result SearchResult, err error) {
+		var resp CustomerSearchResult
+		if resp, err = c.SearchCustomers(params); err != nil {
+			return
+		}
+
+		for _, customer := range resp.Data {
+			page = append(page, customer)
+		}
+
+		result = resp.SearchResult
+		return
+	}
+
+	return &CustomerIterator{newSearchIterator(params, fetch)}
+}
@@ -0,0 +1,111 @@
+package stripe
+
+import (
+	"net/url"
+)
+
+const (
+	CustomerBalanceTransactionTypeAdjustment            = "adjustment"
+	CustomerBalanceTransactionTypeAppliedToInvoice      = "applied_to_invoice"
+	CustomerBalanceTransactionTypeCreditNote            = "credit_note"
+	CustomerBalanceTransactionTypeInitial               = "initial"
+	CustomerBalanceTransactionTypeInvoiceOverpaid       = "invoice_overpaid"
+	CustomerBalanceTransactionTypeInvoiceTooLarge       = "invoice_too_large"
+	CustomerBalanceTransactionTypeInvoiceTooSmall       = "invoice_too_small"
+	CustomerBalanceTransactionTypeMigration             = "migration"
+	CustomerBalanceTransactionTypeUnappliedFromInvoice  = "unapplied_from_invoice"
+	CustomerBalanceTransactionTypeUnspentReceiverCredit = "unspent_receiver_credit"
+)
+
+// CustomerBalanceTransaction represents an increment or decrement of a Customer's balance
+type CustomerBalanceTransaction struct {
+	ID     string `json:"id"`
+	Object string `json:"object"`
+
+	// The ID of the customer the transaction belongs to.
+	Customer string `json:"customer"`
+	// The amount of the transaction. A negative value is a credit for the customer's balance, and a positive value is a debit to the customer's balance.
+	Amount int64 `json:"amount"`
+	// Three-letter ISO currency code, in lowercase.
+	Currency string `json:"currency"`
+	// The customer's balance after the transaction was applied.
+	EndingBalance int64 `json:"ending_balance"`
+	// Transaction type, see the CustomerBalanceTransactionType constants.
+	Type string `json:"type"`
+	// An arbitrary string attached to the object.
+	Description *string `json:"description"`
+	// The ID of the invoice (if any) related to the transaction.
+	Invoice *string `json:"invoice"`
+	// The ID of the credit note (if any) related to the transaction.
+	CreditNote *string `json:"credit_note"`
+
+	Metadata Dictionary `json:"metadata"`
+	Livemode bool       `json:"livemode"`
+	Created  int64      `json:"created"`
+}
+
+// CustomerBalanceTransactionRequest is used to create or update a CustomerBalanceTransaction
+// Only Description and Metadata are used when updating
+type CustomerBalanceTransactionRequest struct {
+	// The integer amount in the smallest currency unit to apply to the customer's balance. Pass a negative amount to credit the customer's balance, and a positive amount to debit the customer's balance.
+	Amount int64 `json:"amount"`
+	// Three-letter ISO currency code, in lowercase. If the customer's currency is set, this value must match it.
+	Currency string `json:"currency"`
+	// An arbitrary string attached to the object. Often useful for displaying to users. (Optional)
+	Description *string `json:"description"`
+
+	Metadata Dictionary `json:"metadata"`
+}
+
+func (c *CustomerBalanceTransactionRequest) ToFormValues() (form url.Values) {
+	// Pre-allocate values with enough space for the basic transaction rows
+	form = make(url.Values, 3)
+	setFormInt64(form, "amount", c.Amount)
+	setFormString(form, "currency", c.Currency)
+	setFormStringPtr(form, "description", c.Description)
+	c.Metadata.AppendFormValues(form, "metadata")
+	return
+}
+
+// CustomerBalanceTransactionList is a paginated list of CustomerBalanceTransactions
+type CustomerBalanceTransactionList struct {
+	List
+	Data []CustomerBalanceTransaction `json:"data"`
+}
+
+func (c *Client) CreateCustomerBalanceTransaction(stripeUserID string, request CustomerBalanceTransactionRequest) (created CustomerBalanceTransaction, err error) {
+	err = c.request("POST", endpointCustomerBalanceTransactionsWithID, &request, &created, stripeUserID)
+	return
+}
+
+func (c *Client) GetCustomerBalanceTransaction(stripeUserID, transactionID string) (transaction CustomerBalanceTransaction, err error) {
+	err = c.request("GET", endpointCustomerBalanceTransactionsWithIDAndTransactionID, nil, &transaction, stripeUserID, transactionID)
+	return
+}
+
+func (c *Client) UpdateCustomerBalanceTransaction(stripeUserID, transactionID string, request CustomerBalanceTransactionRequest) (updated CustomerBalanceTransaction, err error) {
+	// Only the description and metadata of a balance transaction can be updated
+	var req updateCustomerBalanceTransactionRequest
+	req.Description = request.Description
+	req.Metadata = request.Metadata
+
+	err = c.request("POST", endpointCustomerBalanceTransactionsWithIDAndTransactionID, &req, &updated, stripeUserID, transactionID)
+	return
+}
+
+func (c *Client) ListCustomerBalanceTransactions(stripeUserID string, params ListParams) (list CustomerBalanceTransactionList, err error) {
+	err = c.request("GET", endpointCustomerBalanceTransactionsWithID, &params, &list, stripeUserID)
+	return
+}
+
+type updateCustomerBalanceTransactionRequest struct {
+	Description *string    `json:"description"`
+	Metadata    Dictionary `json:"metadata"`
+}
+
+func (u *updateCustomerBalanceTransactionRequest) ToFormValues() (form url.Values) {
+	form = make(url.Values, 1)
+	setFormStringPtr(form, "description", u.Description)
+	u.Metadata.AppendFormValues(form, "metadata")
+	return
+}
@@ -0,0 +1,68 @@
+package stripe
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCustomer_discount(t *testing.T) {
+	r := strings.NewReader(`{
+		"id": "cus_123",
+		"object": "customer",
+		"discount": {
+			"id": "di_123",
+			"object": "discount",
+			"coupon": { "id": "SUMMER", "object": "coupon", "percent_off": 12.5, "duration": "once", "valid": true },
+			"customer": "cus_123",
+			"start": 1600000000,
+			"end": null
+		}
+	}`)
+
+	var customer Customer
+	if err := handleResponse(r, &customer); err != nil {
+		t.Fatal(err)
+	}
+
+	switch {
+	case customer.Discount == nil:
+		t.Fatal("invalid discount, expected non-nil value and received <nil>")
+	case customer.Discount.Coupon.ID != "SUMMER":
+		t.Fatalf("invalid coupon ID, expected <%s> and received <%s>", "SUMMER", customer.Discount.Coupon.ID)
+	case customer.Discount.Coupon.PercentOff == nil || *customer.Discount.Coupon.PercentOff != 12.5:
+		t.Fatalf("invalid percent off, expected %v and received %v", 12.5, customer.Discount.Coupon.PercentOff)
+	}
+
+	if _, ok := customer.ToFormValues()["discount"]; ok {
+		t.Fatal("invalid form values, discount should not be sent")
+	}
+}
+
+func TestCustomer_ToFormValues_balance(t *testing.T) {
+	var customer Customer
+	customer.Name = String("Leeroy Jenkins")
+	if form := customer.ToFormValues(); form.Get("balance") != "" {
+		t.Fatalf("invalid balance, expected no value and received <%s>", form.Get("balance"))
+	}
+
+	customer.Balance = Int64(0)
+	if form := customer.ToFormValues(); form.Get("balance") != "0" {
+		t.Fatalf("invalid balance, expected <%s> and received <%s>", "0", form.Get("balance"))
+	}
+}
+
+func TestCustomer_ToFormValues_taxIDData(t *testing.T) {
+	var customer Customer
+	customer.TaxExempt = String(TaxExemptReverse)
+	customer.TaxIDData = []TaxIDData{{Type: TaxIDTypeEUVAT, Value: "DE123456789"}}
+
+	form := customer.ToFormValues()
+	switch {
+	case form.Get("tax_exempt") != "reverse":
+		t.Fatalf("invalid tax exempt, expected <%s> and received <%s>", "reverse", form.Get("tax_exempt"))
+	case form.Get("tax_id_data[0][type]") != "eu_vat":
+		t.Fatalf("invalid tax ID type, expected <%s> and received <%s>", "eu_vat", form.Get("tax_id_data[0][type]"))
+	case form.Get("tax_id_data[0][value]") != "DE123456789":
+		t.Fatalf("invalid tax ID value, expected <%s> and received <%s>", "DE123456789", form.Get("tax_id_data[0][value]"))
+	}
+}
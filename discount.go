@@ -0,0 +1,39 @@
+package stripe
+
+// Discount represents the actual application of a Coupon or PromotionCode to a Customer or subscription
+type Discount struct {
+	ID     string `json:"id"`
+	Object string `json:"object"`
+
+	// The coupon applied to create this discount.
+	Coupon Coupon `json:"coupon"`
+	// The ID of the customer associated with this discount.
+	Customer *string `json:"customer"`
+	// The subscription that this discount is applied to, if it is applied to a particular subscription.
+	Subscription *string `json:"subscription"`
+	// The invoice that the discount's coupon was applied to, if it was applied directly to a particular invoice.
+	Invoice *string `json:"invoice"`
+	// The invoice item ID the discount's coupon was applied to, if it was applied directly to a particular invoice item.
+	InvoiceItem *string `json:"invoice_item"`
+	// The promotion code applied to create this discount.
+	PromotionCode *string `json:"promotion_code"`
+	// The Checkout session that this discount is applied to, if it is applied to a particular session.
+	CheckoutSession *string `json:"checkout_session"`
+
+	// Date that the coupon was applied.
+	Start int64 `json:"start"`
+	// If the coupon has a duration of repeating, the date that this discount will end. If the coupon has a duration of once or forever, this attribute will be nil.
+	End *int64 `json:"end"`
+}
+
+// RemoveCustomerDiscount will remove the currently applied discount of a Customer
+func (c *Client) RemoveCustomerDiscount(stripeUserID string) (err error) {
+	err = c.request("DELETE", endpointCustomerDiscountWithID, nil, nil, stripeUserID)
+	return
+}
+
+// RemoveSubscriptionDiscount will remove the currently applied discount of a subscription
+func (c *Client) RemoveSubscriptionDiscount(subscriptionID string) (err error) {
+	err = c.request("DELETE", endpointSubscriptionDiscountWithID, nil, nil, subscriptionID)
+	return
+}
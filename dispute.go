@@ -0,0 +1,262 @@
+package stripe
+
+import (
+	"net/url"
+)
+
+const (
+	DisputeStatusWarningNeedsResponse = "warning_needs_response"
+	DisputeStatusWarningUnderReview   = "warning_under_review"
+	DisputeStatusWarningClosed        = "warning_closed"
+	DisputeStatusNeedsResponse        = "needs_response"
+	DisputeStatusUnderReview          = "under_review"
+	DisputeStatusWon                  = "won"
+	DisputeStatusLost                 = "lost"
+
+	DisputeReasonBankCannotProcess       = "bank_cannot_process"
+	DisputeReasonCheckReturned           = "check_returned"
+	DisputeReasonCreditNotProcessed      = "credit_not_processed"
+	DisputeReasonCustomerInitiated       = "customer_initiated"
+	DisputeReasonDebitNotAuthorized      = "debit_not_authorized"
+	DisputeReasonDuplicate               = "duplicate"
+	DisputeReasonFraudulent              = "fraudulent"
+	DisputeReasonGeneral                 = "general"
+	DisputeReasonIncorrectAccountDetails = "incorrect_account_details"
+	DisputeReasonInsufficientFunds       = "insufficient_funds"
+	DisputeReasonProductNotReceived      = "product_not_received"
+	DisputeReasonProductUnacceptable     = "product_unacceptable"
+	DisputeReasonSubscriptionCanceled    = "subscription_canceled"
+	DisputeReasonUnrecognized            = "unrecognized"
+)
+
+// Dispute represents a customer questioning a Charge with their card issuer
+type Dispute struct {
+	ID     string `json:"id"`
+	Object string `json:"object"`
+
+	// Disputed amount. Usually the amount of the charge, but it can differ.
+	Amount int64 `json:"amount"`
+	// Three-letter ISO currency code, in lowercase.
+	Currency string `json:"currency"`
+	// ID of the charge that's disputed.
+	Charge string `json:"charge"`
+	// ID of the PaymentIntent that's disputed.
+	PaymentIntent *string `json:"payment_intent"`
+	// Reason given by cardholder for dispute, see the DisputeReason constants.
+	Reason string `json:"reason"`
+	// Current status of dispute, see the DisputeStatus constants.
+	Status string `json:"status"`
+	// If true, it's still possible to refund the disputed payment.
+	IsChargeRefundable bool `json:"is_charge_refundable"`
+	// Evidence provided to respond to a dispute.
+	Evidence DisputeEvidence `json:"evidence"`
+	// Information about the evidence submission.
+	EvidenceDetails DisputeEvidenceDetails `json:"evidence_details"`
+
+	Metadata Dictionary `json:"metadata"`
+	Livemode bool       `json:"livemode"`
+	Created  int64      `json:"created"`
+}
+
+// DisputeEvidence represents the evidence provided to respond to a Dispute
+// Fields referencing a File expect the ID of a File uploaded with the dispute_evidence purpose
+type DisputeEvidence struct {
+	// Any server or activity logs showing proof that the customer accessed or downloaded the purchased digital product.
+	AccessActivityLog *string `json:"access_activity_log"`
+	// The billing address provided by the customer.
+	BillingAddress *string `json:"billing_address"`
+	// (File ID) Your subscription cancellation policy, as shown to the customer.
+	CancellationPolicy *string `json:"cancellation_policy"`
+	// An explanation of how and when the customer was shown your refund policy prior to purchase.
+	CancellationPolicyDisclosure *string `json:"cancellation_policy_disclosure"`
+	// A justification for why the customer's subscription was not canceled.
+	CancellationRebuttal *string `json:"cancellation_rebuttal"`
+	// (File ID) Any communication with the customer that you feel is relevant to your case.
+	CustomerCommunication *string `json:"customer_communication"`
+	// The email address of the customer.
+	CustomerEmailAddress *string `json:"customer_email_address"`
+	// The name of the customer.
+	CustomerName *string `json:"customer_name"`
+	// The IP address that the customer used when making the purchase.
+	CustomerPurchaseIP *string `json:"customer_purchase_ip"`
+	// (File ID) A relevant document or contract showing the customer's signature.
+	CustomerSignature *string `json:"customer_signature"`
+	// (File ID) Documentation for the prior charge that can uniquely identify the charge, such as a receipt, shipping label, work order, etc.
+	DuplicateChargeDocumentation *string `json:"duplicate_charge_documentation"`
+	// An explanation of the difference between the disputed charge versus the prior charge that appears to be a duplicate.
+	DuplicateChargeExplanation *string `json:"duplicate_charge_explanation"`
+	// The Stripe ID for the prior charge which appears to be a duplicate of the disputed charge.
+	DuplicateChargeID *string `json:"duplicate_charge_id"`
+	// A description of the product or service that was sold.
+	ProductDescription *string `json:"product_description"`
+	// (File ID) Any receipt or message sent to the customer notifying them of the charge.
+	Receipt *string `json:"receipt"`
+	// (File ID) Your refund policy, as shown to the customer.
+	RefundPolicy *string `json:"refund_policy"`
+	// Documentation demonstrating that the customer was shown your refund policy prior to purchase.
+	RefundPolicyDisclosure *string `json:"refund_policy_disclosure"`
+	// A justification for why the customer is not entitled to a refund.
+	RefundRefusalExplanation *string `json:"refund_refusal_explanation"`
+	// The date on which the customer received or began receiving the purchased service, in a clear human-readable format.
+	ServiceDate *string `json:"service_date"`
+	// (File ID) Documentation showing proof that a service was provided to the customer.
+	ServiceDocumentation *string `json:"service_documentation"`
+	// The address to which a physical product was shipped.
+	ShippingAddress *string `json:"shipping_address"`
+	// The delivery service that shipped a physical product, such as Fedex, UPS, USPS, etc.
+	ShippingCarrier *string `json:"shipping_carrier"`
+	// The date on which a physical product began its route to the shipping address, in a clear human-readable format.
+	ShippingDate *string `json:"shipping_date"`
+	// (File ID) Documentation showing proof that a product was shipped to the customer at the same address the customer provided to you.
+	ShippingDocumentation *string `json:"shipping_documentation"`
+	// The tracking number for a physical product, obtained from the delivery service.
+	ShippingTrackingNumber *string `json:"shipping_tracking_number"`
+	// (File ID) Any additional evidence or statements.
+	UncategorizedFile *string `json:"uncategorized_file"`
+	// Any additional evidence or statements.
+	UncategorizedText *string `json:"uncategorized_text"`
+}
+
+func (d *DisputeEvidence) AppendFormValues(values url.Values, key string) {
+	if d == nil {
+		return
+	}
+
+	setFormStringPtr(values, getFieldKey(key, "access_activity_log"), d.AccessActivityLog)
+	setFormStringPtr(values, getFieldKey(key, "billing_address"), d.BillingAddress)
+	setFormStringPtr(values, getFieldKey(key, "cancellation_policy"), d.CancellationPolicy)
+	setFormStringPtr(values, getFieldKey(key, "cancellation_policy_disclosure"), d.CancellationPolicyDisclosure)
+	setFormStringPtr(values, getFieldKey(key, "cancellation_rebuttal"), d.CancellationRebuttal)
+	setFormStringPtr(values, getFieldKey(key, "customer_communication"), d.CustomerCommunication)
+	setFormStringPtr(values, getFieldKey(key, "customer_email_address"), d.CustomerEmailAddress)
+	setFormStringPtr(values, getFieldKey(key, "customer_name"), d.CustomerName)
+	setFormStringPtr(values, getFieldKey(key, "customer_purchase_ip"), d.CustomerPurchaseIP)
+	setFormStringPtr(values, getFieldKey(key, "customer_signature"), d.CustomerSignature)
+	setFormStringPtr(values, getFieldKey(key, "duplicate_charge_documentation"), d.DuplicateChargeDocumentation)
+	setFormStringPtr(values, getFieldKey(key, "duplicate_charge_explanation"), d.DuplicateChargeExplanation)
+	setFormStringPtr(values, getFieldKey(key, "duplicate_charge_id"), d.DuplicateChargeID)
+	setFormStringPtr(values, getFieldKey(key, "product_description"), d.ProductDescription)
+	setFormStringPtr(values, getFieldKey(key, "receipt"), d.Receipt)
+	setFormStringPtr(values, getFieldKey(key, "refund_policy"), d.RefundPolicy)
+	setFormStringPtr(values, getFieldKey(key, "refund_policy_disclosure"), d.RefundPolicyDisclosure)
+	setFormStringPtr(values, getFieldKey(key, "refund_refusal_explanation"), d.RefundRefusalExplanation)
+	setFormStringPtr(values, getFieldKey(key, "service_date"), d.ServiceDate)
+	setFormStringPtr(values, getFieldKey(key, "service_documentation"), d.ServiceDocumentation)
+	setFormStringPtr(values, getFieldKey(key, "shipping_address"), d.ShippingAddress)
+	setFormStringPtr(values, getFieldKey(key, "shipping_carrier"), d.ShippingCarrier)
+	setFormStringPtr(values, getFieldKey(key, "shipping_date"), d.ShippingDate)
+	setFormStringPtr(values, getFieldKey(key, "shipping_documentation"), d.ShippingDocumentation)
+	setFormStringPtr(values, getFieldKey(key, "shipping_tracking_number"), d.ShippingTrackingNumber)
+	setFormStringPtr(values, getFieldKey(key, "uncategorized_file"), d.UncategorizedFile)
+	setFormStringPtr(values, getFieldKey(key, "uncategorized_text"), d.UncategorizedText)
+}
+
+// DisputeEvidenceDetails represent the evidence submission state of a Dispute
+type DisputeEvidenceDetails struct {
+	// Date by which evidence must be submitted in order to successfully challenge dispute.
+	DueBy *int64 `json:"due_by"`
+	// Whether evidence has been staged for this dispute.
+	HasEvidence bool `json:"has_evidence"`
+	// Whether the last evidence submission was submitted past the due date.
+	PastDue bool `json:"past_due"`
+	// The number of times evidence has been submitted.
+	SubmissionCount int64 `json:"submission_count"`
+}
+
+// DisputeRequest is used to update the evidence of a Dispute
+type DisputeRequest struct {
+	// Evidence to upload, to respond to a dispute. Updating any field in the hash will submit all fields in the hash for review. (Optional)
+	Evidence *DisputeEvidence `json:"evidence"`
+	// Whether to immediately submit evidence to the bank. If false, evidence is staged on the dispute. (Optional)
+	Submit *bool `json:"submit"`
+
+	Metadata Dictionary `json:"metadata"`
+}
+
+func (d *DisputeRequest) ToFormValues() (form url.Values) {
+	form = make(url.Values, 2)
+	setFormBoolPtr(form, "submit", d.Submit)
+	d.Evidence.AppendFormValues(form, "evidence")
+	d.Metadata.AppendFormValues(form, "metadata")
+	return
+}
+
+// DisputeListRequest is used to list Disputes
+type DisputeListRequest struct {
+	ListParams
+
+	// Only return disputes associated to the charge specified by this charge ID. (Optional)
+	Charge *string `json:"charge"`
+	// Only return disputes associated to the PaymentIntent specified by this PaymentIntent ID. (Optional)
+	PaymentIntent *string `json:"payment_intent"`
+	// Only return disputes that were created during the given date interval. (Optional)
+	Created *RangeQuery `json:"created"`
+}
+
+func (d *DisputeListRequest) ToFormValues() (form url.Values) {
+	form = d.ListParams.ToFormValues()
+	setFormStringPtr(form, "charge", d.Charge)
+	setFormStringPtr(form, "payment_intent", d.PaymentIntent)
+	d.Created.AppendFormValues(form, "created")
+	return
+}
+
+// DisputeList is a paginated list of Disputes
+type DisputeList struct {
+	List
+	Data []Dispute `json:"data"`
+}
+
+// DisputeIterator iterates over every Dispute of a paginated list
+type DisputeIterator struct {
+	*Iterator
+}
+
+// Dispute returns the Dispute the iterator is currently at
+func (d *DisputeIterator) Dispute() (dispute Dispute) {
+	dispute, _ = d.Current().(Dispute)
+	return
+}
+
+func (c *Client) GetDispute(disputeID string) (dispute Dispute, err error) {
+	err = c.request("GET", endpointDisputesWithID, nil, &dispute, disputeID)
+	return
+}
+
+func (c *Client) UpdateDispute(disputeID string, request DisputeRequest) (updated Dispute, err error) {
+	err = c.request("POST", endpointDisputesWithID, &request, &updated, disputeID)
+	return
+}
+
+// CloseDispute will dismiss a Dispute, acknowledging it as lost
+func (c *Client) CloseDispute(disputeID string) (closed Dispute, err error) {
+	err = c.request("POST", endpointDisputesCloseWithID, nil, &closed, disputeID)
+	return
+}
+
+func (c *Client) ListDisputes(request DisputeListRequest) (list DisputeList, err error) {
+	err = c.request("GET", endpointDisputes, &request, &list)
+	return
+}
+
+// IterateDisputes will return an iterator over every Dispute matching the request, fetching pages as needed
+func (c *Client) IterateDisputes(request DisputeListRequest) *DisputeIterator {
+	fetch := func(params ListParams) (page []interface{}, lastID string, hasMore bool, err error) {
+		request.ListParams = params
+
+		var list DisputeList
+		if list, err = c.ListDisputes(request); err != nil {
+			return
+		}
+
+		for _, dispute := range list.Data {
+			page = append(page, dispute)
+			lastID = dispute.ID
+		}
+
+		hasMore = list.HasMore
+		return
+	}
+
+	return &DisputeIterator{newIterator(request.ListParams, fetch)}
+}
@@ -0,0 +1,73 @@
+package stripe
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+func TestDisputeRequest_ToFormValues(t *testing.T) {
+	var req DisputeRequest
+	req.Submit = Bool(true)
+	req.Evidence = &DisputeEvidence{
+		CustomerName:           String("Jenny Rosen"),
+		ShippingTrackingNumber: String("1Z999AA10123456784"),
+		UncategorizedFile:      String("file_123"),
+	}
+
+	wanted := url.Values{
+		"submit":                             {"true"},
+		"evidence[customer_name]":            {"Jenny Rosen"},
+		"evidence[shipping_tracking_number]": {"1Z999AA10123456784"},
+		"evidence[uncategorized_file]":       {"file_123"},
+	}
+
+	if form := req.ToFormValues(); !reflect.DeepEqual(wanted, form) {
+		t.Fatalf("invalid form values, expected %v and received %v", wanted, form)
+	}
+}
+
+func TestClient_IterateDisputes(t *testing.T) {
+	pages := map[string]string{
+		"":     `{"object":"list","has_more":true,"data":[{"id":"dp_1"},{"id":"dp_2"}]}`,
+		"dp_2": `{"object":"list","has_more":false,"data":[{"id":"dp_3"}]}`,
+	}
+
+	var queries []url.Values
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/disputes" {
+			t.Errorf("invalid path, expected <%s> and received <%s>", "/v1/disputes", r.URL.Path)
+		}
+
+		queries = append(queries, r.URL.Query())
+		fmt.Fprint(w, pages[r.URL.Query().Get("starting_after")])
+	}))
+	defer s.Close()
+
+	var req DisputeListRequest
+	req.Charge = String("ch_123")
+	req.Limit = Int64(2)
+
+	var ids []string
+	it := newTestServerClient(t, s.URL).IterateDisputes(req)
+	for it.Next() {
+		ids = append(ids, it.Dispute().ID)
+	}
+
+	if err := it.Err(); err != nil {
+		t.Fatal(err)
+	}
+
+	if wanted := []string{"dp_1", "dp_2", "dp_3"}; !reflect.DeepEqual(wanted, ids) {
+		t.Fatalf("invalid IDs, expected %v and received %v", wanted, ids)
+	}
+
+	for _, query := range queries {
+		if query.Get("charge") != "ch_123" || query.Get("limit") != "2" {
+			t.Fatalf("invalid query, expected the charge and limit to be kept across pages and received %v", query)
+		}
+	}
+}
@@ -1,5 +1,7 @@
 package stripe
 
+import "fmt"
+
 type ErrorResponse struct {
 	Error Error `json:"error"`
 }
@@ -17,8 +19,15 @@ type Error struct {
 	Message string `json:"message"`
 	// If the error is parameter-specific, the parameter related to the error. For example, you can use this to display a message near the correct form field.
 	Param string `json:"param"`
+
+	// The ID of the connected account the request was made on behalf of, if any
+	Account string `json:"-"`
 }
 
 func (e *Error) Error() string {
+	if len(e.Account) > 0 {
+		return fmt.Sprintf("%s (account: <%s>)", e.Message, e.Account)
+	}
+
 	return e.Message
 }
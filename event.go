@@ -0,0 +1,156 @@
+package stripe
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrInvalidSignatureHeader is returned when a webhook Stripe-Signature header cannot be parsed
+	ErrInvalidSignatureHeader = errors.New("invalid Stripe-Signature header, expected timestamp and v1 signature")
+	// ErrInvalidSignature is returned when no webhook signature matches the expected signature
+	ErrInvalidSignature = errors.New("invalid webhook signature, no signatures matched the expected signature")
+	// ErrSignatureExpired is returned when a webhook signature timestamp is outside of the allowed tolerance
+	ErrSignatureExpired = errors.New("webhook signature timestamp is outside of the allowed tolerance")
+)
+
+const (
+	EventTypeCheckoutSessionCompleted             = "checkout.session.completed"
+	EventTypeCheckoutSessionAsyncPaymentSucceeded = "checkout.session.async_payment_succeeded"
+	EventTypeCheckoutSessionAsyncPaymentFailed    = "checkout.session.async_payment_failed"
+	EventTypeCheckoutSessionExpired               = "checkout.session.expired"
+)
+
+// DefaultSignatureTolerance is the default maximum age of a webhook signature
+const DefaultSignatureTolerance = 5 * time.Minute
+
+// Event represents a webhook event sent by Stripe
+type Event struct {
+	ID     string `json:"id"`
+	Object string `json:"object"`
+
+	// Description of the event (e.g., invoice.created or charge.refunded).
+	Type string `json:"type"`
+	// The Stripe API version used to render data.
+	APIVersion *string `json:"api_version"`
+	// The connected account that originates the event.
+	Account *string `json:"account"`
+	// Object containing data associated with the event.
+	Data EventData `json:"data"`
+	// Information on the API request that triggers the event.
+	Request *EventRequest `json:"request"`
+	// Number of webhooks that haven't been successfully delivered.
+	PendingWebhooks int64 `json:"pending_webhooks"`
+
+	Livemode bool  `json:"livemode"`
+	Created  int64 `json:"created"`
+}
+
+// UnmarshalObject will decode the event data object into the provided value
+// Example: An event of type checkout.session.completed can be decoded into a CheckoutSession
+func (e *Event) UnmarshalObject(value interface{}) (err error) {
+	if err = json.Unmarshal(e.Data.Object, value); err != nil {
+		return fmt.Errorf("error decoding %s event object: %v", e.Type, err)
+	}
+
+	return
+}
+
+// EventData contains the object associated with an Event
+type EventData struct {
+	// Object containing the API resource relevant to the event.
+	Object json.RawMessage `json:"object"`
+	// Object containing the names of the updated attributes and their values prior to the event (only included in events of type *.updated).
+	PreviousAttributes json.RawMessage `json:"previous_attributes"`
+}
+
+// EventRequest represents the API request which triggered an Event
+type EventRequest struct {
+	// ID of the API request that caused the event.
+	ID *string `json:"id"`
+	// The idempotency key transmitted during the request, if any.
+	IdempotencyKey *string `json:"idempotency_key"`
+}
+
+// ParseEvent will verify the Stripe-Signature header of a webhook payload and decode it as an Event
+func ParseEvent(payload []byte, signatureHeader, secret string) (event Event, err error) {
+	return ParseEventWithTolerance(payload, signatureHeader, secret, DefaultSignatureTolerance)
+}
+
+// ParseEventWithTolerance will verify the Stripe-Signature header of a webhook payload and decode it as an Event
+// A tolerance of zero disables the timestamp check
+func ParseEventWithTolerance(payload []byte, signatureHeader, secret string, tolerance time.Duration) (event Event, err error) {
+	if err = verifySignature(payload, signatureHeader, secret, tolerance, time.Now()); err != nil {
+		return
+	}
+
+	if err = json.Unmarshal(payload, &event); err != nil {
+		err = fmt.Errorf("error decoding event: %v", err)
+		return
+	}
+
+	return
+}
+
+func verifySignature(payload []byte, signatureHeader, secret string, tolerance time.Duration, now time.Time) (err error) {
+	var (
+		timestamp  string
+		signatures [][]byte
+	)
+
+	for _, pair := range strings.Split(signatureHeader, ",") {
+		parts := strings.SplitN(strings.TrimSpace(pair), "=", 2)
+		if len(parts) != 2 {
+			continue
+		}
+
+		switch parts[0] {
+		case "t":
+			timestamp = parts[1]
+		case "v1":
+			var signature []byte
+			if signature, err = hex.DecodeString(parts[1]); err != nil {
+				// Skip malformed signatures, another may still match
+				err = nil
+				continue
+			}
+
+			signatures = append(signatures, signature)
+		}
+	}
+
+	var unix int64
+	if unix, err = strconv.ParseInt(timestamp, 10, 64); err != nil || len(signatures) == 0 {
+		return ErrInvalidSignatureHeader
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	expected := mac.Sum(nil)
+
+	var matched bool
+	for _, signature := range signatures {
+		if hmac.Equal(expected, signature) {
+			matched = true
+			break
+		}
+	}
+
+	switch {
+	case !matched:
+		return ErrInvalidSignature
+	case tolerance > 0 && now.Sub(time.Unix(unix, 0)) > tolerance:
+		return ErrSignatureExpired
+	}
+
+	return
+}
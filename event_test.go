@@ -0,0 +1,91 @@
+package stripe
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestParseEvent(t *testing.T) {
+	payload := []byte(`{
+		"id": "evt_123",
+		"object": "event",
+		"type": "checkout.session.completed",
+		"data": { "object": { "id": "cs_test_123", "object": "checkout.session", "mode": "payment", "payment_status": "paid" } }
+	}`)
+
+	secret := "whsec_test"
+	now := time.Now().Unix()
+	header := fmt.Sprintf("t=%d,v1=%s", now, testSignature(now, payload, secret))
+
+	event, err := ParseEvent(payload, header, secret)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if event.Type != EventTypeCheckoutSessionCompleted {
+		t.Fatalf("invalid event type, expected <%s> and received <%s>", EventTypeCheckoutSessionCompleted, event.Type)
+	}
+
+	var session CheckoutSession
+	if err = event.UnmarshalObject(&session); err != nil {
+		t.Fatal(err)
+	}
+
+	switch {
+	case session.ID != "cs_test_123":
+		t.Fatalf("invalid session ID, expected <%s> and received <%s>", "cs_test_123", session.ID)
+	case session.PaymentStatus != CheckoutSessionPaymentStatusPaid:
+		t.Fatalf("invalid payment status, expected <%s> and received <%s>", CheckoutSessionPaymentStatusPaid, session.PaymentStatus)
+	}
+}
+
+func Test_verifySignature(t *testing.T) {
+	type testcase struct {
+		header string
+		wanted error
+	}
+
+	payload := []byte(`{"id":"evt_123"}`)
+	secret := "whsec_test"
+	now := time.Now()
+	stale := now.Add(-time.Hour).Unix()
+
+	tcs := []testcase{
+		{
+			header: fmt.Sprintf("t=%d,v1=%s", now.Unix(), testSignature(now.Unix(), payload, secret)),
+			wanted: nil,
+		},
+		{
+			header: fmt.Sprintf("t=%d,v1=deadbeef,v1=%s", now.Unix(), testSignature(now.Unix(), payload, secret)),
+			wanted: nil,
+		},
+		{
+			header: fmt.Sprintf("t=%d,v1=%s", now.Unix(), testSignature(now.Unix(), payload, "whsec_other")),
+			wanted: ErrInvalidSignature,
+		},
+		{
+			header: fmt.Sprintf("t=%d,v1=%s", stale, testSignature(stale, payload, secret)),
+			wanted: ErrSignatureExpired,
+		},
+		{
+			header: "v1=deadbeef",
+			wanted: ErrInvalidSignatureHeader,
+		},
+	}
+
+	for _, tc := range tcs {
+		if err := verifySignature(payload, tc.header, secret, DefaultSignatureTolerance, now); err != tc.wanted {
+			t.Fatalf("invalid error, expected %v and received %v", tc.wanted, err)
+		}
+	}
+}
+
+func testSignature(timestamp int64, payload []byte, secret string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	fmt.Fprintf(mac, "%d.%s", timestamp, payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,143 @@
+package stripe
+
+import (
+	"encoding/json"
+	"net/url"
+)
+
+const (
+	ExternalAccountObjectBankAccount = "bank_account"
+	ExternalAccountObjectCard        = "card"
+)
+
+// ExternalAccount represents a bank account or debit card which a connected Account can be paid out to
+// Only one of BankAccount and Card is set, depending on the Object type. When the Object type is missing or
+// unknown, neither is set and the undecoded object is kept as Raw.
+type ExternalAccount struct {
+	ID     string `json:"id"`
+	Object string `json:"object"`
+
+	BankAccount *BankAccount `json:"-"`
+	Card        *Card        `json:"-"`
+	// The JSON of an external account which is neither a bank account nor a card
+	Raw json.RawMessage `json:"-"`
+}
+
+func (e *ExternalAccount) UnmarshalJSON(bs []byte) (err error) {
+	var header struct {
+		ID     string `json:"id"`
+		Object string `json:"object"`
+	}
+
+	if err = json.Unmarshal(bs, &header); err != nil {
+		return
+	}
+
+	e.ID = header.ID
+	e.Object = header.Object
+	switch e.Object {
+	case ExternalAccountObjectBankAccount:
+		e.BankAccount = &BankAccount{}
+		return json.Unmarshal(bs, e.BankAccount)
+	case ExternalAccountObjectCard:
+		e.Card = &Card{}
+		return json.Unmarshal(bs, e.Card)
+	}
+
+	e.Raw = append(json.RawMessage(nil), bs...)
+	return
+}
+
+// ExternalAccountRequest is used to add an ExternalAccount to an Account
+type ExternalAccountRequest struct {
+	// A token of a bank account or debit card, see CreateBankAccountToken and CreateCardToken.
+	ExternalAccount string `json:"external_account"`
+	// When set to true, or if this is the first external account added in this currency, this account becomes the default external account for its currency. (Optional)
+	DefaultForCurrency *bool `json:"default_for_currency"`
+
+	Metadata Dictionary `json:"metadata"`
+}
+
+func (e *ExternalAccountRequest) ToFormValues() (form url.Values) {
+	form = make(url.Values, 2)
+	setFormString(form, "external_account", e.ExternalAccount)
+	setFormBoolPtr(form, "default_for_currency", e.DefaultForCurrency)
+	e.Metadata.AppendFormValues(form, "metadata")
+	return
+}
+
+// ExternalAccountUpdateRequest is used to update an ExternalAccount
+// The account holder fields only apply to bank accounts, the expiration and cardholder fields only apply to cards
+type ExternalAccountUpdateRequest struct {
+	// The name of the person or business that owns the bank account. (Optional)
+	AccountHolderName *string `json:"account_holder_name"`
+	// The type of entity that holds the account, either individual or company. (Optional)
+	AccountHolderType *string `json:"account_holder_type"`
+	// Two digit number representing the card's expiration month. (Optional)
+	ExpirationMonth *int64 `json:"exp_month"`
+	// Four digit number representing the card's expiration year. (Optional)
+	ExpirationYear *int64 `json:"exp_year"`
+	// Cardholder name. (Optional)
+	CardholderName *string `json:"name"`
+	// When set to true, this becomes the default external account for its currency. (Optional)
+	DefaultForCurrency *bool `json:"default_for_currency"`
+
+	Metadata Dictionary `json:"metadata"`
+}
+
+func (e *ExternalAccountUpdateRequest) ToFormValues() (form url.Values) {
+	form = make(url.Values, 1)
+	setFormStringPtr(form, "account_holder_name", e.AccountHolderName)
+	setFormStringPtr(form, "account_holder_type", e.AccountHolderType)
+	setFormInt64Ptr(form, "exp_month", e.ExpirationMonth)
+	setFormInt64Ptr(form, "exp_year", e.ExpirationYear)
+	setFormStringPtr(form, "name", e.CardholderName)
+	setFormBoolPtr(form, "default_for_currency", e.DefaultForCurrency)
+	e.Metadata.AppendFormValues(form, "metadata")
+	return
+}
+
+// ExternalAccountListRequest is used to list the ExternalAccounts of an Account
+type ExternalAccountListRequest struct {
+	ListParams
+
+	// Filter external accounts according to a particular object type, either bank_account or card. (Optional)
+	Object *string `json:"object"`
+}
+
+func (e *ExternalAccountListRequest) ToFormValues() (form url.Values) {
+	form = e.ListParams.ToFormValues()
+	setFormStringPtr(form, "object", e.Object)
+	return
+}
+
+// ExternalAccountList is a paginated list of ExternalAccounts
+type ExternalAccountList struct {
+	List
+	Data []ExternalAccount `json:"data"`
+}
+
+func (c *Client) CreateExternalAccount(accountID string, request ExternalAccountRequest) (created ExternalAccount, err error) {
+	err = c.request("POST", endpointExternalAccountsWithID, &request, &created, accountID)
+	return
+}
+
+func (c *Client) GetExternalAccount(accountID, externalAccountID string) (externalAccount ExternalAccount, err error) {
+	err = c.request("GET", endpointExternalAccountsWithIDAndExternalAccountID, nil, &externalAccount, accountID, externalAccountID)
+	return
+}
+
+func (c *Client) UpdateExternalAccount(accountID, externalAccountID string, request ExternalAccountUpdateRequest) (updated ExternalAccount, err error) {
+	err = c.request("POST", endpointExternalAccountsWithIDAndExternalAccountID, &request, &updated, accountID, externalAccountID)
+	return
+}
+
+func (c *Client) RemoveExternalAccount(accountID, externalAccountID string) (err error) {
+	err = c.request("DELETE", endpointExternalAccountsWithIDAndExternalAccountID, nil, nil, accountID, externalAccountID)
+	return
+}
+
+func (c *Client) ListExternalAccounts(accountID string, request ExternalAccountListRequest) (list ExternalAccountList, err error) {
+	err = c.request("GET", endpointExternalAccountsWithID, &request, &list, accountID)
+	return
+}
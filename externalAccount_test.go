@@ -0,0 +1,142 @@
+package stripe
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestExternalAccountList(t *testing.T) {
+	r := strings.NewReader(`{
+		"object": "list",
+		"has_more": false,
+		"data": [
+			{ "id": "ba_123", "object": "bank_account", "last4": "6789", "routing_number": "110000000", "status": "new", "default_for_currency": true },
+			{ "id": "card_123", "object": "card", "last4": "5556", "brand": "Visa", "currency": "usd" }
+		]
+	}`)
+
+	var list ExternalAccountList
+	if err := handleResponse(r, &list); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(list.Data) != 2 {
+		t.Fatalf("invalid number of external accounts, expected %d and received %d", 2, len(list.Data))
+	}
+
+	bank, card := list.Data[0], list.Data[1]
+	switch {
+	case bank.BankAccount == nil || bank.Card != nil:
+		t.Fatalf("invalid bank account, expected only a bank account and received %+v", bank)
+	case bank.BankAccount.LastFour != "6789" || !bank.BankAccount.DefaultForCurrency:
+		t.Fatalf("invalid bank account, received %+v", bank.BankAccount)
+	case card.Card == nil || card.BankAccount != nil:
+		t.Fatalf("invalid card, expected only a card and received %+v", card)
+	case card.ID != "card_123" || card.Card.LastFour != "5556":
+		t.Fatalf("invalid card, received %+v", card.Card)
+	}
+}
+
+func TestToken_ToFormValues_bankAccount(t *testing.T) {
+	var token Token
+	token.BankAccount = &BankAccount{
+		AccountNumber: "000123456789",
+		Country:       "US",
+		Currency:      "usd",
+		RoutingNumber: String("110000000"),
+	}
+
+	form := token.ToFormValues()
+	switch {
+	case form.Get("bank_account[account_number]") != "000123456789":
+		t.Fatalf("invalid account number, expected <%s> and received <%s>", "000123456789", form.Get("bank_account[account_number]"))
+	case form.Get("bank_account[routing_number]") != "110000000":
+		t.Fatalf("invalid routing number, expected <%s> and received <%s>", "110000000", form.Get("bank_account[routing_number]"))
+	case form.Get("card[exp_month]") != "":
+		t.Fatalf("invalid form values, card should not be sent and received <%s>", form.Get("card[exp_month]"))
+	}
+}
+
+func TestExternalAccount_unknown_object(t *testing.T) {
+	tcs := []string{
+		`{ "id": "ea_123", "object": "crypto_wallet", "network": "ethereum" }`,
+		`{ "id": "ea_123", "network": "ethereum" }`,
+	}
+
+	for _, tc := range tcs {
+		var e ExternalAccount
+		if err := handleResponse(strings.NewReader(tc), &e); err != nil {
+			t.Fatal(err)
+		}
+
+		switch {
+		case e.ID != "ea_123":
+			t.Fatalf("invalid ID, expected <%s> and received <%s>", "ea_123", e.ID)
+		case e.BankAccount != nil || e.Card != nil:
+			t.Fatalf("invalid external account, expected neither a bank account nor a card and received %+v", e)
+		case !strings.Contains(string(e.Raw), `"network": "ethereum"`):
+			t.Fatalf("invalid raw external account, expected the original JSON and received <%s>", e.Raw)
+		}
+	}
+}
+
+func TestClient_CreateExternalAccount_debit_card(t *testing.T) {
+	var (
+		mux   sync.Mutex
+		forms = make(map[string]url.Values)
+	)
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mux.Lock()
+		defer mux.Unlock()
+		if err := r.ParseForm(); err != nil {
+			t.Error(err)
+		}
+
+		forms[r.URL.Path] = r.PostForm
+		switch r.URL.Path {
+		case "/v1/tokens":
+			_, _ = w.Write([]byte(`{"id":"tok_123","object":"token","type":"card","card":{"id":"card_123","object":"card","last4":"5556","currency":"usd"}}`))
+		case "/v1/accounts/acct_123/external_accounts":
+			_, _ = w.Write([]byte(`{"id":"card_123","object":"card","account":"acct_123","last4":"5556","currency":"usd","default_for_currency":true}`))
+		default:
+			w.WriteHeader(404)
+		}
+	}))
+	defer s.Close()
+
+	var card Card
+	card.CardNumber = "4000056655665556"
+	card.ExpirationMonth = 12
+	card.ExpirationYear = 2099
+	card.Currency = String("usd")
+
+	c := newTestServerClient(t, s.URL)
+	token, err := c.CreateCardToken(card)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var req ExternalAccountRequest
+	req.ExternalAccount = token.ID
+
+	created, err := c.CreateExternalAccount("acct_123", req)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mux.Lock()
+	defer mux.Unlock()
+	switch {
+	case forms["/v1/tokens"].Get("card[currency]") != "usd":
+		t.Fatalf("invalid token currency, expected <%s> and received <%s>", "usd", forms["/v1/tokens"].Get("card[currency]"))
+	case forms["/v1/accounts/acct_123/external_accounts"].Get("external_account") != "tok_123":
+		t.Fatalf("invalid external account, expected <%s> and received <%s>", "tok_123", forms["/v1/accounts/acct_123/external_accounts"].Get("external_account"))
+	case created.Card == nil || created.Card.LastFour != "5556" || !created.Card.DefaultForCurrency:
+		t.Fatalf("invalid debit card, received %+v", created)
+	}
+}
@@ -0,0 +1,200 @@
+package stripe
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+)
+
+// ErrEmptyFile is returned when a file upload is attempted without a file
+var ErrEmptyFile = errors.New("invalid file upload, file cannot be nil")
+
+const (
+	FilePurposeAccountRequirement         = "account_requirement"
+	FilePurposeAdditionalVerification     = "additional_verification"
+	FilePurposeBusinessIcon               = "business_icon"
+	FilePurposeBusinessLogo               = "business_logo"
+	FilePurposeCustomerSignature          = "customer_signature"
+	FilePurposeDisputeEvidence            = "dispute_evidence"
+	FilePurposeIdentityDocument           = "identity_document"
+	FilePurposePCIDocument                = "pci_document"
+	FilePurposeTaxDocumentUserUpload      = "tax_document_user_upload"
+	FilePurposeTerminalReaderSplashscreen = "terminal_reader_splashscreen"
+)
+
+// File represents a file hosted on Stripe's servers
+type File struct {
+	ID     string `json:"id"`
+	Object string `json:"object"`
+
+	// The purpose of the uploaded file, see the FilePurpose constants.
+	Purpose string `json:"purpose"`
+	// The suitable name for saving the file to a filesystem.
+	Filename *string `json:"filename"`
+	// A user friendly title for the document.
+	Title *string `json:"title"`
+	// The returned file type (for example, csv, pdf, jpg, or png).
+	Type *string `json:"type"`
+	// The size of the file object in bytes.
+	Size int64 `json:"size"`
+	// Use your live secret API key to download the file from this URL.
+	URL *string `json:"url"`
+	// The file expires and isn't available at this time in epoch seconds.
+	ExpiresAt *int64 `json:"expires_at"`
+	// A list of file links that point at this file.
+	Links *FileLinkList `json:"links"`
+
+	Created int64 `json:"created"`
+}
+
+// FileUploadRequest is used to upload a File
+type FileUploadRequest struct {
+	// The purpose of the uploaded file, see the FilePurpose constants.
+	Purpose string
+	// The name of the uploaded file, including it's extension (e.g. receipt.pdf).
+	Filename string
+	// The contents of the file to upload.
+	File io.Reader
+	// Optional parameters to automatically create a FileLink for the newly created file. (Optional)
+	FileLinkData *FileLinkData
+}
+
+// FileLinkData is used to create a FileLink alongside an uploaded File
+type FileLinkData struct {
+	// Set this to true to create a file link for the newly created file.
+	Create bool
+	// The link isn't available after this future timestamp. (Optional)
+	ExpiresAt *int64
+
+	Metadata Dictionary
+}
+
+func (f *FileLinkData) writeMultipart(w *multipart.Writer) (err error) {
+	if f == nil {
+		return
+	}
+
+	if err = w.WriteField("file_link_data[create]", strconv.FormatBool(f.Create)); err != nil {
+		return
+	}
+
+	if f.ExpiresAt != nil {
+		if err = w.WriteField("file_link_data[expires_at]", strconv.FormatInt(*f.ExpiresAt, 10)); err != nil {
+			return
+		}
+	}
+
+	for key, value := range f.Metadata {
+		if err = w.WriteField(fmt.Sprintf("file_link_data[metadata][%s]", key), value); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+func (f *FileUploadRequest) writeMultipart(w *multipart.Writer) (err error) {
+	if f.File == nil {
+		return ErrEmptyFile
+	}
+
+	if err = w.WriteField("purpose", f.Purpose); err != nil {
+		return
+	}
+
+	if err = f.FileLinkData.writeMultipart(w); err != nil {
+		return
+	}
+
+	var part io.Writer
+	if part, err = w.CreateFormFile("file", f.Filename); err != nil {
+		return
+	}
+
+	_, err = io.Copy(part, f.File)
+	return
+}
+
+// UploadFile will upload a File to the Stripe files host using a multipart/form-data request
+// The file is streamed while the request is sent, rather than being read into memory first
+func (c *Client) UploadFile(request FileUploadRequest) (created File, err error) {
+	if request.File == nil {
+		return created, ErrEmptyFile
+	}
+
+	if err = c.upload(endpointFiles, &request, &created); err != nil {
+		err = wrapFileError("error uploading file", err)
+		return
+	}
+
+	return
+}
+
+func (c *Client) GetFile(fileID string) (file File, err error) {
+	err = c.request("GET", endpointFilesWithID, nil, &file, fileID)
+	return
+}
+
+func (c *Client) ListFiles(request FileListRequest) (list FileList, err error) {
+	err = c.request("GET", endpointFiles, &request, &list)
+	return
+}
+
+// DownloadFile will stream the contents of a File from the Stripe files host
+// Note: The caller is responsible for closing the returned reader
+func (c *Client) DownloadFile(fileID string) (contents io.ReadCloser, err error) {
+	u := *c.uploadURL
+	u.Path = path.Join(apiVersion, formatEndpoint(endpointFileContentsWithID, []string{fileID}))
+
+	var req *http.Request
+	if req, err = http.NewRequestWithContext(c.context(), "GET", u.String(), nil); err != nil {
+		err = fmt.Errorf("error creating request: %v", err)
+		return
+	}
+
+	var resp *http.Response
+	if resp, _, err = c.send(req, endpointFileContentsWithID, nil); err != nil {
+		err = wrapFileError("error downloading file", err)
+		return
+	}
+
+	contents = resp.Body
+	return
+}
+
+// wrapFileError adds context to an error, ErrUnauthorized is returned as is so it can still be compared with ==
+func wrapFileError(action string, err error) error {
+	if err == ErrUnauthorized {
+		return err
+	}
+
+	return fmt.Errorf("%s: %w", action, err)
+}
+
+// FileListRequest is used to list Files
+type FileListRequest struct {
+	ListParams
+
+	// Filter queries by the file purpose. If you don't provide a purpose, the queries return unfiltered files. (Optional)
+	Purpose *string `json:"purpose"`
+	// Only return files that were created during the given date interval. (Optional)
+	Created *RangeQuery `json:"created"`
+}
+
+func (f *FileListRequest) ToFormValues() (form url.Values) {
+	form = f.ListParams.ToFormValues()
+	setFormStringPtr(form, "purpose", f.Purpose)
+	f.Created.AppendFormValues(form, "created")
+	return
+}
+
+// FileList is a paginated list of Files
+type FileList struct {
+	List
+	Data []File `json:"data"`
+}
@@ -0,0 +1,106 @@
+package stripe
+
+import (
+	"net/url"
+)
+
+// FileLink represents a publicly accessible URL to download the contents of a File
+type FileLink struct {
+	ID     string `json:"id"`
+	Object string `json:"object"`
+
+	// The file object this link points to.
+	File string `json:"file"`
+	// The publicly accessible URL to download the file.
+	URL *string `json:"url"`
+	// Returns if the link is already expired.
+	Expired bool `json:"expired"`
+	// Time that the link expires.
+	ExpiresAt *int64 `json:"expires_at"`
+
+	Metadata Dictionary `json:"metadata"`
+	Livemode bool       `json:"livemode"`
+	Created  int64      `json:"created"`
+}
+
+// FileLinkRequest is used to create or update a FileLink
+type FileLinkRequest struct {
+	// The ID of the file. Required on creation, ignored on update.
+	File string `json:"file"`
+	// The link isn't usable after this future timestamp. (Optional)
+	ExpiresAt *int64 `json:"expires_at"`
+
+	Metadata Dictionary `json:"metadata"`
+}
+
+func (f *FileLinkRequest) ToFormValues() (form url.Values) {
+	form = make(url.Values, 2)
+	setFormString(form, "file", f.File)
+	setFormInt64Ptr(form, "expires_at", f.ExpiresAt)
+	f.Metadata.AppendFormValues(form, "metadata")
+	return
+}
+
+// FileLinkListRequest is used to list FileLinks
+type FileLinkListRequest struct {
+	ListParams
+
+	// Only return links for the given file. (Optional)
+	File *string `json:"file"`
+	// Filter links by their expiration status. By default, Stripe returns all links. (Optional)
+	Expired *bool `json:"expired"`
+	// Only return links that were created during the given date interval. (Optional)
+	Created *RangeQuery `json:"created"`
+}
+
+func (f *FileLinkListRequest) ToFormValues() (form url.Values) {
+	form = f.ListParams.ToFormValues()
+	setFormStringPtr(form, "file", f.File)
+	setFormBoolPtr(form, "expired", f.Expired)
+	f.Created.AppendFormValues(form, "created")
+	return
+}
+
+// FileLinkList is a paginated list of FileLinks
+type FileLinkList struct {
+	List
+	Data []FileLink `json:"data"`
+}
+
+type expireFileLinkRequest struct{}
+
+func (e *expireFileLinkRequest) ToFormValues() (form url.Values) {
+	form = make(url.Values, 1)
+	form.Set("expires_at", "now")
+	return
+}
+
+func (c *Client) CreateFileLink(request FileLinkRequest) (created FileLink, err error) {
+	err = c.request("POST", endpointFileLinks, &request, &created)
+	return
+}
+
+func (c *Client) GetFileLink(fileLinkID string) (fileLink FileLink, err error) {
+	err = c.request("GET", endpointFileLinksWithID, nil, &fileLink, fileLinkID)
+	return
+}
+
+func (c *Client) UpdateFileLink(fileLinkID string, request FileLinkRequest) (updated FileLink, err error) {
+	// The file of a link cannot be changed
+	request.File = ""
+
+	err = c.request("POST", endpointFileLinksWithID, &request, &updated, fileLinkID)
+	return
+}
+
+// ExpireFileLink will immediately expire a FileLink
+func (c *Client) ExpireFileLink(fileLinkID string) (updated FileLink, err error) {
+	var req expireFileLinkRequest
+	err = c.request("POST", endpointFileLinksWithID, &req, &updated, fileLinkID)
+	return
+}
+
+func (c *Client) ListFileLinks(request FileLinkListRequest) (list FileLinkList, err error) {
+	err = c.request("GET", endpointFileLinks, &request, &list)
+	return
+}
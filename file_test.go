@@ -0,0 +1,144 @@
+package stripe
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestClient_UploadFile(t *testing.T) {
+	var (
+		mux      sync.Mutex
+		received struct{ path, purpose, filename, contents string }
+	)
+
+	// Handlers run on the server's goroutine, so failures are reported with t.Errorf and asserted below
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mux.Lock()
+		defer mux.Unlock()
+		received.path = r.URL.Path
+		if err := r.ParseMultipartForm(1 << 20); err != nil {
+			t.Errorf("error parsing multipart form: %v", err)
+			w.WriteHeader(500)
+			return
+		}
+
+		received.purpose = r.FormValue("purpose")
+		f, header, err := r.FormFile("file")
+		if err != nil {
+			t.Errorf("error reading file: %v", err)
+			w.WriteHeader(500)
+			return
+		}
+		defer f.Close()
+
+		bs, err := ioutil.ReadAll(f)
+		if err != nil {
+			t.Errorf("error reading file: %v", err)
+			w.WriteHeader(500)
+			return
+		}
+
+		received.filename = header.Filename
+		received.contents = string(bs)
+		_, _ = w.Write([]byte(`{"id":"file_123","object":"file","purpose":"dispute_evidence","size":11}`))
+	}))
+	defer s.Close()
+
+	c := newTestServerClient(t, s.URL)
+
+	var req FileUploadRequest
+	req.Purpose = FilePurposeDisputeEvidence
+	req.Filename = "receipt.txt"
+	req.File = strings.NewReader("order #1337")
+
+	created, err := c.UploadFile(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mux.Lock()
+	upload := received
+	mux.Unlock()
+
+	switch {
+	case upload.path != "/v1/files":
+		t.Fatalf("invalid path, expected <%s> and received <%s>", "/v1/files", upload.path)
+	case upload.purpose != FilePurposeDisputeEvidence:
+		t.Fatalf("invalid purpose, expected <%s> and received <%s>", FilePurposeDisputeEvidence, upload.purpose)
+	case upload.filename != "receipt.txt":
+		t.Fatalf("invalid filename, expected <%s> and received <%s>", "receipt.txt", upload.filename)
+	case upload.contents != "order #1337":
+		t.Fatalf("invalid file contents, expected <%s> and received <%s>", "order #1337", upload.contents)
+	case created.ID != "file_123":
+		t.Fatalf("invalid file ID, expected <%s> and received <%s>", "file_123", created.ID)
+	}
+
+	if _, err = c.UploadFile(FileUploadRequest{Purpose: FilePurposeDisputeEvidence}); err != ErrEmptyFile {
+		t.Fatalf("invalid error, expected <%v> and received <%v>", ErrEmptyFile, err)
+	}
+}
+
+func TestClient_DownloadFile(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/files/file_123/contents":
+			_, _ = w.Write([]byte("order #1337"))
+		default:
+			w.WriteHeader(404)
+			_, _ = w.Write([]byte(`{"error":{"type":"invalid_request_error","message":"No such file upload"}}`))
+		}
+	}))
+	defer s.Close()
+
+	c := newTestServerClient(t, s.URL)
+	contents, err := c.DownloadFile("file_123")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer contents.Close()
+
+	bs, err := ioutil.ReadAll(contents)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(bs) != "order #1337" {
+		t.Fatalf("invalid file contents, expected <%s> and received <%s>", "order #1337", string(bs))
+	}
+
+	wanted := "error downloading file: No such file upload"
+	if _, err = c.DownloadFile("file_404"); err == nil || err.Error() != wanted {
+		t.Fatalf("invalid error, expected <%s> and received <%v>", wanted, err)
+	}
+
+	var stripeErr *Error
+	if !errors.As(err, &stripeErr) || stripeErr.Type != "invalid_request_error" {
+		t.Fatalf("invalid error, expected a wrapped *Error and received <%v>", err)
+	}
+}
+
+func TestClient_UploadFile_unauthorized(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(401)
+	}))
+	defer s.Close()
+
+	var req FileUploadRequest
+	req.Purpose = FilePurposeDisputeEvidence
+	req.Filename = "receipt.txt"
+	req.File = strings.NewReader("order #1337")
+
+	c := newTestServerClient(t, s.URL)
+	if _, err := c.UploadFile(req); err != ErrUnauthorized {
+		t.Fatalf("invalid error, expected <%v> and received <%v>", ErrUnauthorized, err)
+	}
+
+	if _, err := c.DownloadFile("file_123"); err != ErrUnauthorized {
+		t.Fatalf("invalid error, expected <%v> and received <%v>", ErrUnauthorized, err)
+	}
+}
@@ -0,0 +1,360 @@
+package stripe
+
+import (
+	"net/url"
+)
+
+const (
+	InvoiceStatusDraft         = "draft"
+	InvoiceStatusOpen          = "open"
+	InvoiceStatusPaid          = "paid"
+	InvoiceStatusUncollectible = "uncollectible"
+	InvoiceStatusVoid          = "void"
+
+	CollectionMethodChargeAutomatically = "charge_automatically"
+	CollectionMethodSendInvoice         = "send_invoice"
+)
+
+// Invoice represents a statement of amounts owed by a Customer
+type Invoice struct {
+	ID     string `json:"id"`
+	Object string `json:"object"`
+
+	// The ID of the customer who will be billed.
+	Customer string `json:"customer"`
+	// The status of the invoice, one of draft, open, paid, uncollectible, or void.
+	Status string `json:"status"`
+	// A unique, identifying string that appears on emails sent to the customer for this invoice.
+	Number *string `json:"number"`
+	// Three-letter ISO currency code, in lowercase.
+	Currency string `json:"currency"`
+
+	// Final amount due at this time for this invoice.
+	AmountDue int64 `json:"amount_due"`
+	// The amount, in the smallest currency unit, that was paid.
+	AmountPaid int64 `json:"amount_paid"`
+	// The difference between amount_due and amount_paid.
+	AmountRemaining int64 `json:"amount_remaining"`
+	// Total of all line items before discounts or exclusive taxes are applied.
+	Subtotal int64 `json:"subtotal"`
+	// Total after discounts and taxes.
+	Total int64 `json:"total"`
+
+	// Either charge_automatically, or send_invoice.
+	CollectionMethod string `json:"collection_method"`
+	// Controls whether Stripe performs automatic collection of the invoice.
+	AutoAdvance bool `json:"auto_advance"`
+	// The date on which payment for this invoice is due. Only set for invoices with collection_method=send_invoice.
+	DueDate *int64 `json:"due_date"`
+	// Whether payment was successfully collected for this invoice.
+	Paid bool `json:"paid"`
+	// Whether an attempt has been made to pay the invoice.
+	Attempted bool `json:"attempted"`
+	// Number of payment attempts made for this invoice.
+	AttemptCount int64 `json:"attempt_count"`
+	// The time at which payment will next be attempted.
+	NextPaymentAttempt *int64 `json:"next_payment_attempt"`
+
+	// An arbitrary string attached to the object. Displayed as 'memo' in the Dashboard.
+	Description *string `json:"description"`
+	// Footer displayed on the invoice.
+	Footer *string `json:"footer"`
+	// Custom fields displayed on the invoice.
+	CustomFields []CustomField `json:"custom_fields"`
+
+	// The URL for the hosted invoice page.
+	HostedInvoiceURL *string `json:"hosted_invoice_url"`
+	// The link to download the PDF for the invoice.
+	InvoicePDF *string `json:"invoice_pdf"`
+
+	// ID of the latest charge generated for this invoice, if any.
+	Charge *string `json:"charge"`
+	// The subscription that this invoice was prepared for, if any.
+	Subscription *string `json:"subscription"`
+	// The individual line items that make up the invoice.
+	Lines InvoiceLineItemList `json:"lines"`
+
+	// Start of the usage period during which invoice items were added to this invoice.
+	PeriodStart int64 `json:"period_start"`
+	// End of the usage period during which invoice items were added to this invoice.
+	PeriodEnd int64 `json:"period_end"`
+
+	Metadata Dictionary `json:"metadata"`
+	Livemode bool       `json:"livemode"`
+	Created  int64      `json:"created"`
+}
+
+// InvoiceRequest is used to create or update an Invoice
+type InvoiceRequest struct {
+	// The ID of the customer who will be billed. Required on creation, ignored on update.
+	Customer string `json:"customer"`
+	// The ID of the subscription to invoice, if any. Only used on creation. (Optional)
+	Subscription *string `json:"subscription"`
+	// Either charge_automatically, or send_invoice. (Optional)
+	CollectionMethod *string `json:"collection_method"`
+	// Controls whether Stripe performs automatic collection of the invoice. (Optional)
+	AutoAdvance *bool `json:"auto_advance"`
+	// The number of days from when the invoice is created until it is due. Only valid for send_invoice invoices. (Optional)
+	DaysUntilDue *int64 `json:"days_until_due"`
+	// The date on which payment for this invoice is due. Only valid for send_invoice invoices. (Optional)
+	DueDate *int64 `json:"due_date"`
+	// ID of the default payment method for the invoice. (Optional)
+	DefaultPaymentMethod *string `json:"default_payment_method"`
+	// How to handle pending invoice items on creation, either include or exclude. (Optional)
+	PendingInvoiceItemsBehavior *string `json:"pending_invoice_items_behavior"`
+
+	// An arbitrary string attached to the object. Displayed as 'memo' in the Dashboard. (Optional)
+	Description *string `json:"description"`
+	// Footer to be displayed on the invoice. (Optional)
+	Footer *string `json:"footer"`
+	// A list of up to 4 custom fields to be displayed on the invoice. (Optional)
+	CustomFields []CustomField `json:"custom_fields"`
+
+	Metadata Dictionary `json:"metadata"`
+}
+
+func (i *InvoiceRequest) ToFormValues() (form url.Values) {
+	// Pre-allocate values with enough space for the basic invoice rows
+	form = make(url.Values, 6)
+	setFormString(form, "customer", i.Customer)
+	setFormStringPtr(form, "subscription", i.Subscription)
+	setFormStringPtr(form, "collection_method", i.CollectionMethod)
+	setFormBoolPtr(form, "auto_advance", i.AutoAdvance)
+	setFormInt64Ptr(form, "days_until_due", i.DaysUntilDue)
+	setFormInt64Ptr(form, "due_date", i.DueDate)
+	setFormStringPtr(form, "default_payment_method", i.DefaultPaymentMethod)
+	setFormStringPtr(form, "pending_invoice_items_behavior", i.PendingInvoiceItemsBehavior)
+	setFormStringPtr(form, "description", i.Description)
+	setFormStringPtr(form, "footer", i.Footer)
+	appendCustomFields(form, "custom_fields", i.CustomFields)
+	i.Metadata.AppendFormValues(form, "metadata")
+	return
+}
+
+// InvoiceFinalizeRequest is used to finalize a draft Invoice
+type InvoiceFinalizeRequest struct {
+	// Controls whether Stripe performs automatic collection of the invoice. (Optional)
+	AutoAdvance *bool `json:"auto_advance"`
+}
+
+func (i *InvoiceFinalizeRequest) ToFormValues() (form url.Values) {
+	form = make(url.Values, 1)
+	setFormBoolPtr(form, "auto_advance", i.AutoAdvance)
+	return
+}
+
+// InvoicePayRequest is used to pay an open Invoice
+type InvoicePayRequest struct {
+	// In cases where the source used to pay the invoice has insufficient funds, passing forgive=true will mark the invoice as paid. (Optional)
+	Forgive *bool `json:"forgive"`
+	// Whether the invoice was paid outside of Stripe. (Optional)
+	PaidOutOfBand *bool `json:"paid_out_of_band"`
+	// ID of the payment method to pay the invoice with. (Optional)
+	PaymentMethod *string `json:"payment_method"`
+	// ID of the source to pay the invoice with. (Optional)
+	Source *string `json:"source"`
+}
+
+func (i *InvoicePayRequest) ToFormValues() (form url.Values) {
+	form = make(url.Values, 4)
+	setFormBoolPtr(form, "forgive", i.Forgive)
+	setFormBoolPtr(form, "paid_out_of_band", i.PaidOutOfBand)
+	setFormStringPtr(form, "payment_method", i.PaymentMethod)
+	setFormStringPtr(form, "source", i.Source)
+	return
+}
+
+// InvoiceListRequest is used to list Invoices
+type InvoiceListRequest struct {
+	ListParams
+
+	// Only return invoices for the customer specified by this customer ID. (Optional)
+	Customer *string `json:"customer"`
+	// Only return invoices for the subscription specified by this subscription ID. (Optional)
+	Subscription *string `json:"subscription"`
+	// The status of the invoice, one of draft, open, paid, uncollectible, or void. (Optional)
+	Status *string `json:"status"`
+	// The collection method of the invoice to retrieve. Either charge_automatically or send_invoice. (Optional)
+	CollectionMethod *string `json:"collection_method"`
+	// Only return invoices that were created during the given date interval. (Optional)
+	Created *RangeQuery `json:"created"`
+	// Only return invoices that are due during the given date interval. (Optional)
+	DueDate *RangeQuery `json:"due_date"`
+}
+
+func (i *InvoiceListRequest) ToFormValues() (form url.Values) {
+	form = i.ListParams.ToFormValues()
+	setFormStringPtr(form, "customer", i.Customer)
+	setFormStringPtr(form, "subscription", i.Subscription)
+	setFormStringPtr(form, "status", i.Status)
+	setFormStringPtr(form, "collection_method", i.CollectionMethod)
+	i.Created.AppendFormValues(form, "created")
+	i.DueDate.AppendFormValues(form, "due_date")
+	return
+}
+
+// UpcomingInvoiceRequest is used to preview the upcoming Invoice of a Customer
+type UpcomingInvoiceRequest struct {
+	// The identifier of the customer whose upcoming invoice you'd like to retrieve.
+	Customer string `json:"customer"`
+	// The identifier of the subscription for which you'd like to retrieve the upcoming invoice. (Optional)
+	Subscription *string `json:"subscription"`
+}
+
+func (u *UpcomingInvoiceRequest) ToFormValues() (form url.Values) {
+	form = make(url.Values, 2)
+	setFormString(form, "customer", u.Customer)
+	setFormStringPtr(form, "subscription", u.Subscription)
+	return
+}
+
+// InvoiceList is a paginated list of Invoices
+type InvoiceList struct {
+	List
+	Data []Invoice `json:"data"`
+}
+
+// InvoiceSearchResult is a paginated list of Invoices matching a search query
+type InvoiceSearchResult struct {
+	SearchResult
+	Data []Invoice `json:"data"`
+}
+
+// InvoiceIterator iterates over every Invoice of a paginated list
+type InvoiceIterator struct {
+	*Iterator
+}
+
+// Invoice returns the Invoice the iterator is currently at
+func (i *InvoiceIterator) Invoice() (invoice Invoice) {
+	invoice, _ = i.Current().(Invoice)
+	return
+}
+
+// InvoiceLineItem represents a single line of an Invoice
+type InvoiceLineItem struct {
+	ID     string `json:"id"`
+	Object string `json:"object"`
+
+	// A string identifying the type of the source of this line item, either an invoiceitem or a subscription.
+	Type string `json:"type"`
+	// The amount, in the smallest currency unit.
+	Amount int64 `json:"amount"`
+	// Three-letter ISO currency code, in lowercase.
+	Currency string `json:"currency"`
+	// An arbitrary string attached to the object.
+	Description *string `json:"description"`
+	// The ID of the invoice item associated with this line item, if any.
+	InvoiceItem *string `json:"invoice_item"`
+	// The subscription that the invoice item pertains to, if any.
+	Subscription *string `json:"subscription"`
+	// The subscription item that generated this line item, if any.
+	SubscriptionItem *string `json:"subscription_item"`
+	// The price of the line item.
+	Price *Price `json:"price"`
+	// The quantity of the subscription, if the line item is a subscription or a proration.
+	Quantity *int64 `json:"quantity"`
+	// Whether this is a proration.
+	Proration bool `json:"proration"`
+	// The period this line item covers.
+	Period Period `json:"period"`
+
+	Metadata Dictionary `json:"metadata"`
+	Livemode bool       `json:"livemode"`
+}
+
+// InvoiceLineItemList is a paginated list of InvoiceLineItems
+type InvoiceLineItemList struct {
+	List
+	Data []InvoiceLineItem `json:"data"`
+}
+
+func (c *Client) CreateInvoice(request InvoiceRequest) (created Invoice, err error) {
+	err = c.request("POST", endpointInvoices, &request, &created)
+	return
+}
+
+func (c *Client) GetInvoice(invoiceID string) (invoice Invoice, err error) {
+	err = c.request("GET", endpointInvoicesWithID, nil, &invoice, invoiceID)
+	return
+}
+
+func (c *Client) UpdateInvoice(invoiceID string, request InvoiceRequest) (updated Invoice, err error) {
+	// The customer, subscription and pending invoice item behavior can only be provided on creation
+	request.Customer = ""
+	request.Subscription = nil
+	request.PendingInvoiceItemsBehavior = nil
+	err = c.request("POST", endpointInvoicesWithID, &request, &updated, invoiceID)
+	return
+}
+
+// RemoveInvoice will permanently delete a draft Invoice
+func (c *Client) RemoveInvoice(invoiceID string) (err error) {
+	err = c.request("DELETE", endpointInvoicesWithID, nil, nil, invoiceID)
+	return
+}
+
+func (c *Client) FinalizeInvoice(invoiceID string, request InvoiceFinalizeRequest) (finalized Invoice, err error) {
+	err = c.request("POST", endpointInvoicesFinalizeWithID, &request, &finalized, invoiceID)
+	return
+}
+
+func (c *Client) PayInvoice(invoiceID string, request InvoicePayRequest) (paid Invoice, err error) {
+	err = c.request("POST", endpointInvoicesPayWithID, &request, &paid, invoiceID)
+	return
+}
+
+func (c *Client) SendInvoice(invoiceID string) (sent Invoice, err error) {
+	err = c.request("POST", endpointInvoicesSendWithID, nil, &sent, invoiceID)
+	return
+}
+
+func (c *Client) VoidInvoice(invoiceID string) (voided Invoice, err error) {
+	err = c.request("POST", endpointInvoicesVoidWithID, nil, &voided, invoiceID)
+	return
+}
+
+func (c *Client) MarkInvoiceUncollectible(invoiceID string) (marked Invoice, err error) {
+	err = c.request("POST", endpointInvoicesMarkUncollectibleWithID, nil, &marked, invoiceID)
+	return
+}
+
+func (c *Client) ListInvoices(request InvoiceListRequest) (list InvoiceList, err error) {
+	err = c.request("GET", endpointInvoices, &request, &list)
+	return
+}
+
+func (c *Client) SearchInvoices(params SearchParams) (result InvoiceSearchResult, err error) {
+	err = c.request("GET", endpointInvoicesSearch, &params, &result)
+	return
+}
+
+// IterateInvoiceSearch will return an iterator over every Invoice matching the search query, fetching pages as needed
+func (c *Client) IterateInvoiceSearch(params SearchParams) *InvoiceIterator {
+	fetch := func(params SearchParams) (page []interface{}, result SearchResult, err error) {
+		var resp InvoiceSearchResult
+		if resp, err = c.SearchInvoices(params); err != nil {
+			return
+		}
+
+		for _, invoice := range resp.Data {
+			page = append(page, invoice)
+		}
+
+		result = resp.SearchResult
+		return
+	}
+
+	return &InvoiceIterator{newSearchIterator(params, fetch)}
+}
+
+// GetUpcomingInvoice will preview the next Invoice of a Customer, the returned Invoice has no ID
+func (c *Client) GetUpcomingInvoice(request UpcomingInvoiceRequest) (upcoming Invoice, err error) {
+	err = c.request("GET", endpointInvoicesUpcoming, &request, &upcoming)
+	return
+}
+
+func (c *Client) ListInvoiceLines(invoiceID string, params ListParams) (list InvoiceLineItemList, err error) {
+	err = c.request("GET", endpointInvoiceLinesWithID, &params, &list, invoiceID)
+	return
+}
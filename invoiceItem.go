@@ -0,0 +1,139 @@
+package stripe
+
+import (
+	"net/url"
+)
+
+// InvoiceItem represents a pending charge or credit added to a Customer's next Invoice
+type InvoiceItem struct {
+	ID     string `json:"id"`
+	Object string `json:"object"`
+
+	// The ID of the customer who will be billed when this invoice item is billed.
+	Customer string `json:"customer"`
+	// The ID of the invoice this invoice item belongs to, nil while pending.
+	Invoice *string `json:"invoice"`
+	// Amount (in the currency specified) of the invoice item.
+	Amount int64 `json:"amount"`
+	// Three-letter ISO currency code, in lowercase.
+	Currency string `json:"currency"`
+	// An arbitrary string attached to the object.
+	Description *string `json:"description"`
+	// If true, discounts will apply to this invoice item.
+	Discountable bool `json:"discountable"`
+	// The price of the invoice item.
+	Price *Price `json:"price"`
+	// Quantity of units for the invoice item.
+	Quantity int64 `json:"quantity"`
+	// Unit amount (in the currency specified) of the invoice item.
+	UnitAmount *int64 `json:"unit_amount"`
+	// The period associated with this invoice item.
+	Period Period `json:"period"`
+	// Whether the invoice item was created automatically as a proration adjustment when the customer switched plans.
+	Proration bool `json:"proration"`
+
+	Metadata Dictionary `json:"metadata"`
+	Livemode bool       `json:"livemode"`
+	Date     int64      `json:"date"`
+}
+
+// InvoiceItemRequest is used to create or update an InvoiceItem
+type InvoiceItemRequest struct {
+	// The ID of the customer who will be billed. Required on creation, ignored on update.
+	Customer string `json:"customer"`
+	// The ID of an existing draft invoice to add this invoice item to. Only used on creation. (Optional)
+	Invoice *string `json:"invoice"`
+	// The integer amount in the smallest currency unit of the charge to be applied to the upcoming invoice. (Optional)
+	Amount *int64 `json:"amount"`
+	// Three-letter ISO currency code, in lowercase. Only used on creation. (Optional)
+	Currency *string `json:"currency"`
+	// The ID of the price object. (Optional)
+	Price *string `json:"price"`
+	// Non-negative integer. The quantity of units for the invoice item. (Optional)
+	Quantity *int64 `json:"quantity"`
+	// The integer unit amount in the smallest currency unit of the charge to be applied to the upcoming invoice. (Optional)
+	UnitAmount *int64 `json:"unit_amount"`
+	// An arbitrary string which you can attach to the invoice item. (Optional)
+	Description *string `json:"description"`
+	// Controls whether discounts apply to this invoice item. (Optional)
+	Discountable *bool `json:"discountable"`
+	// The period associated with this invoice item. (Optional)
+	Period *Period `json:"period"`
+
+	Metadata Dictionary `json:"metadata"`
+}
+
+func (i *InvoiceItemRequest) ToFormValues() (form url.Values) {
+	// Pre-allocate values with enough space for the basic invoice item rows
+	form = make(url.Values, 4)
+	setFormString(form, "customer", i.Customer)
+	setFormStringPtr(form, "invoice", i.Invoice)
+	setFormInt64Ptr(form, "amount", i.Amount)
+	setFormStringPtr(form, "currency", i.Currency)
+	setFormStringPtr(form, "price", i.Price)
+	setFormInt64Ptr(form, "quantity", i.Quantity)
+	setFormInt64Ptr(form, "unit_amount", i.UnitAmount)
+	setFormStringPtr(form, "description", i.Description)
+	setFormBoolPtr(form, "discountable", i.Discountable)
+	i.Period.AppendFormValues(form, "period")
+	i.Metadata.AppendFormValues(form, "metadata")
+	return
+}
+
+// InvoiceItemListRequest is used to list InvoiceItems
+type InvoiceItemListRequest struct {
+	ListParams
+
+	// The identifier of the customer whose invoice items to return. (Optional)
+	Customer *string `json:"customer"`
+	// Only return invoice items belonging to this invoice. (Optional)
+	Invoice *string `json:"invoice"`
+	// Set to true to only show pending invoice items, which are not yet attached to any invoices. (Optional)
+	Pending *bool `json:"pending"`
+	// Only return invoice items that were created during the given date interval. (Optional)
+	Created *RangeQuery `json:"created"`
+}
+
+func (i *InvoiceItemListRequest) ToFormValues() (form url.Values) {
+	form = i.ListParams.ToFormValues()
+	setFormStringPtr(form, "customer", i.Customer)
+	setFormStringPtr(form, "invoice", i.Invoice)
+	setFormBoolPtr(form, "pending", i.Pending)
+	i.Created.AppendFormValues(form, "created")
+	return
+}
+
+// InvoiceItemList is a paginated list of InvoiceItems
+type InvoiceItemList struct {
+	List
+	Data []InvoiceItem `json:"data"`
+}
+
+func (c *Client) CreateInvoiceItem(request InvoiceItemRequest) (created InvoiceItem, err error) {
+	err = c.request("POST", endpointInvoiceItems, &request, &created)
+	return
+}
+
+func (c *Client) GetInvoiceItem(invoiceItemID string) (item InvoiceItem, err error) {
+	err = c.request("GET", endpointInvoiceItemsWithID, nil, &item, invoiceItemID)
+	return
+}
+
+func (c *Client) UpdateInvoiceItem(invoiceItemID string, request InvoiceItemRequest) (updated InvoiceItem, err error) {
+	// The customer, invoice and currency can only be provided on creation
+	request.Customer = ""
+	request.Invoice = nil
+	request.Currency = nil
+	err = c.request("POST", endpointInvoiceItemsWithID, &request, &updated, invoiceItemID)
+	return
+}
+
+func (c *Client) RemoveInvoiceItem(invoiceItemID string) (err error) {
+	err = c.request("DELETE", endpointInvoiceItemsWithID, nil, nil, invoiceItemID)
+	return
+}
+
+func (c *Client) ListInvoiceItems(request InvoiceItemListRequest) (list InvoiceItemList, err error) {
+	err = c.request("GET", endpointInvoiceItems, &request, &list)
+	return
+}
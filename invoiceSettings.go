@@ -1,12 +1,15 @@
 package stripe
 
-import "net/url"
+import (
+	"fmt"
+	"net/url"
+)
 
 // InvoiceSettings represent Customer invoice settings
 type InvoiceSettings struct {
-	CustomFields         Dictionary `json:"custom_fields"`
-	DefaultPaymentMethod *string    `json:"default_payment_method"`
-	Footer               *string    `json:"footer"`
+	CustomFields         []CustomField `json:"custom_fields"`
+	DefaultPaymentMethod *string       `json:"default_payment_method"`
+	Footer               *string       `json:"footer"`
 }
 
 func (i *InvoiceSettings) AppendFormValues(values url.Values, key string) {
@@ -14,5 +17,23 @@ func (i *InvoiceSettings) AppendFormValues(values url.Values, key string) {
 		return
 	}
 
-	// TODO: Implement this
+	appendCustomFields(values, getFieldKey(key, "custom_fields"), i.CustomFields)
+	setFormStringPtr(values, getFieldKey(key, "default_payment_method"), i.DefaultPaymentMethod)
+	setFormStringPtr(values, getFieldKey(key, "footer"), i.Footer)
+}
+
+// CustomField represents a custom field displayed on an invoice
+type CustomField struct {
+	// The name of the custom field. This may be up to 40 characters.
+	Name string `json:"name"`
+	// The value of the custom field. This may be up to 140 characters.
+	Value string `json:"value"`
+}
+
+func appendCustomFields(values url.Values, key string, fields []CustomField) {
+	for i, field := range fields {
+		fieldKey := fmt.Sprintf("%s[%d]", key, i)
+		setFormString(values, getFieldKey(fieldKey, "name"), field.Name)
+		setFormString(values, getFieldKey(fieldKey, "value"), field.Value)
+	}
 }
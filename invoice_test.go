@@ -0,0 +1,95 @@
+package stripe
+
+import (
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+func TestInvoiceRequest_ToFormValues(t *testing.T) {
+	var req InvoiceRequest
+	req.Customer = "cus_123"
+	req.CollectionMethod = String(CollectionMethodSendInvoice)
+	req.DaysUntilDue = Int64(30)
+	req.Footer = String("Thank you!")
+	req.CustomFields = []CustomField{
+		{Name: "PO Number", Value: "1337"},
+		{Name: "VAT", Value: "GB123"},
+	}
+
+	wanted := url.Values{
+		"customer":                {"cus_123"},
+		"collection_method":       {"send_invoice"},
+		"days_until_due":          {"30"},
+		"footer":                  {"Thank you!"},
+		"custom_fields[0][name]":  {"PO Number"},
+		"custom_fields[0][value]": {"1337"},
+		"custom_fields[1][name]":  {"VAT"},
+		"custom_fields[1][value]": {"GB123"},
+	}
+
+	if form := req.ToFormValues(); !reflect.DeepEqual(wanted, form) {
+		t.Fatalf("invalid form values, expected %v and received %v", wanted, form)
+	}
+}
+
+func TestClient_getURL(t *testing.T) {
+	c := newTestServerClient(t, host)
+
+	var req InvoiceListRequest
+	req.Customer = String("cus_123")
+	req.Limit = Int64(5)
+
+	wanted := "https://api.stripe.com/v1/invoices?customer=cus_123&limit=5"
+	if u := c.getURL("GET", endpointInvoices, &req); u != wanted {
+		t.Fatalf("invalid URL, expected <%s> and received <%s>", wanted, u)
+	}
+
+	wanted = "https://api.stripe.com/v1/invoices"
+	if u := c.getURL("POST", endpointInvoices, &req); u != wanted {
+		t.Fatalf("invalid URL, expected <%s> and received <%s>", wanted, u)
+	}
+}
+
+func TestClient_UpdateInvoice(t *testing.T) {
+	s, form := newFormServer(t, `{"id":"in_123","object":"invoice"}`)
+	defer s.Close()
+
+	var req InvoiceRequest
+	req.Customer = "cus_123"
+	req.Subscription = String("sub_123")
+	req.PendingInvoiceItemsBehavior = String("exclude")
+	req.Footer = String("Thank you!")
+
+	c := newTestServerClient(t, s.URL)
+	if _, err := c.UpdateInvoice("in_123", req); err != nil {
+		t.Fatal(err)
+	}
+
+	wanted := url.Values{"footer": {"Thank you!"}}
+	if sent := form(); !reflect.DeepEqual(wanted, sent) {
+		t.Fatalf("invalid form values, expected %v and received %v", wanted, sent)
+	}
+}
+
+func TestClient_UpdateInvoiceItem(t *testing.T) {
+	s, form := newFormServer(t, `{"id":"ii_123","object":"invoiceitem"}`)
+	defer s.Close()
+
+	var req InvoiceItemRequest
+	req.Customer = "cus_123"
+	req.Invoice = String("in_123")
+	req.Currency = String("usd")
+	req.Amount = Int64(1500)
+	req.Description = String("Setup fee")
+
+	c := newTestServerClient(t, s.URL)
+	if _, err := c.UpdateInvoiceItem("ii_123", req); err != nil {
+		t.Fatal(err)
+	}
+
+	wanted := url.Values{"amount": {"1500"}, "description": {"Setup fee"}}
+	if sent := form(); !reflect.DeepEqual(wanted, sent) {
+		t.Fatalf("invalid form values, expected %v and received %v", wanted, sent)
+	}
+}
@@ -0,0 +1,69 @@
+package stripe
+
+// newIterator initializes and returns a new Iterator
+func newIterator(params ListParams, fetch pageFetcher) *Iterator {
+	var it Iterator
+	it.params = params
+	it.fetch = fetch
+	it.hasMore = true
+	return &it
+}
+
+// Iterator will iterate over every object of a paginated list, fetching additional pages as they are needed
+// Iteration always moves forward using the ID of the last object (or the next page token of a search) as the StartingAfter cursor
+type Iterator struct {
+	fetch  pageFetcher
+	params ListParams
+
+	page    []interface{}
+	current interface{}
+	hasMore bool
+	err     error
+}
+
+// Next will advance the Iterator to the next object, fetching the next page when needed
+// False is returned when all objects have been iterated over, or when an error is encountered
+func (it *Iterator) Next() bool {
+	if len(it.page) == 0 && it.hasMore && it.err == nil {
+		it.nextPage()
+	}
+
+	if len(it.page) == 0 {
+		it.current = nil
+		return false
+	}
+
+	it.current = it.page[0]
+	it.page = it.page[1:]
+	return true
+}
+
+// Current returns the object the Iterator is currently at
+func (it *Iterator) Current() interface{} {
+	return it.current
+}
+
+// Err returns the error encountered while fetching a page, if any
+func (it *Iterator) Err() error {
+	return it.err
+}
+
+func (it *Iterator) nextPage() {
+	var lastID string
+	if it.page, lastID, it.hasMore, it.err = it.fetch(it.params); it.err != nil {
+		it.page = nil
+		return
+	}
+
+	if len(lastID) == 0 {
+		// Nothing to continue on from, avoid requesting the same page again
+		it.hasMore = false
+		return
+	}
+
+	it.params.StartingAfter = String(lastID)
+	it.params.EndingBefore = nil
+}
+
+// pageFetcher will fetch a single page of objects for the provided pagination parameters
+type pageFetcher func(params ListParams) (page []interface{}, lastID string, hasMore bool, err error)
@@ -0,0 +1,64 @@
+package stripe
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestIterator(t *testing.T) {
+	pages := map[string][]string{
+		"":      {"a", "b"},
+		"b":     {"c", "d"},
+		"d":     {"e"},
+		"error": nil,
+	}
+
+	var cursors []string
+	fetch := func(params ListParams) (page []interface{}, lastID string, hasMore bool, err error) {
+		var cursor string
+		if params.StartingAfter != nil {
+			cursor = *params.StartingAfter
+		}
+
+		cursors = append(cursors, cursor)
+		for _, id := range pages[cursor] {
+			page = append(page, id)
+			lastID = id
+		}
+
+		hasMore = cursor != "d"
+		return
+	}
+
+	var ids []string
+	it := newIterator(ListParams{}, fetch)
+	for it.Next() {
+		ids = append(ids, it.Current().(string))
+	}
+
+	if err := it.Err(); err != nil {
+		t.Fatal(err)
+	}
+
+	if wanted := []string{"a", "b", "c", "d", "e"}; !reflect.DeepEqual(wanted, ids) {
+		t.Fatalf("invalid IDs, expected %v and received %v", wanted, ids)
+	}
+
+	if wanted := []string{"", "b", "d"}; !reflect.DeepEqual(wanted, cursors) {
+		t.Fatalf("invalid cursors, expected %v and received %v", wanted, cursors)
+	}
+
+	failure := errors.New("foobar")
+	it = newIterator(ListParams{}, func(params ListParams) ([]interface{}, string, bool, error) {
+		return nil, "", true, failure
+	})
+
+	if it.Next() {
+		t.Fatal("invalid iteration, expected Next to return false")
+	}
+
+	if err := it.Err(); err != failure {
+		t.Fatalf("invalid error, expected %v and received %v", failure, err)
+	}
+}
@@ -0,0 +1,165 @@
+package stripe
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// LineItem represents a purchased item within a checkout session or payment link
+type LineItem struct {
+	ID     string `json:"id"`
+	Object string `json:"object"`
+
+	// An arbitrary string attached to the object. Defaults to product name.
+	Description string `json:"description"`
+	// The price used to generate the line item.
+	Price *Price `json:"price"`
+	// The quantity of products being purchased.
+	Quantity *int64 `json:"quantity"`
+	// Three-letter ISO currency code, in lowercase.
+	Currency string `json:"currency"`
+	// Total discount amount applied. If no discounts were applied, defaults to 0.
+	AmountDiscount int64 `json:"amount_discount"`
+	// Total before any discounts or taxes are applied.
+	AmountSubtotal int64 `json:"amount_subtotal"`
+	// Total tax amount applied. If no tax was applied, defaults to 0.
+	AmountTax int64 `json:"amount_tax"`
+	// Total after discounts and taxes.
+	AmountTotal int64 `json:"amount_total"`
+}
+
+// LineItemList is a paginated list of LineItems
+type LineItemList struct {
+	List
+	Data []LineItem `json:"data"`
+}
+
+// LineItemRequest is used to describe a purchased item, using either an existing Price or inline PriceData
+type LineItemRequest struct {
+	// The ID of an existing line item, only used when updating a payment link. (Optional)
+	ID *string `json:"id"`
+	// The ID of the Price or Plan object. One of Price or PriceData is required.
+	Price *string `json:"price"`
+	// Data used to generate a new Price object inline. One of Price or PriceData is required.
+	PriceData *PriceData `json:"price_data"`
+	// The quantity of the line item being purchased.
+	Quantity *int64 `json:"quantity"`
+	// When set, provides configuration for this item's quantity to be adjusted by the customer during checkout. (Optional)
+	AdjustableQuantity *AdjustableQuantity `json:"adjustable_quantity"`
+	// The tax rates which apply to this line item. (Optional)
+	TaxRates []string `json:"tax_rates"`
+}
+
+func (l *LineItemRequest) AppendFormValues(values url.Values, key string) {
+	if l == nil {
+		return
+	}
+
+	setFormStringPtr(values, getFieldKey(key, "id"), l.ID)
+	setFormStringPtr(values, getFieldKey(key, "price"), l.Price)
+	setFormInt64Ptr(values, getFieldKey(key, "quantity"), l.Quantity)
+	setFormStringSlice(values, getFieldKey(key, "tax_rates"), l.TaxRates)
+	l.PriceData.AppendFormValues(values, getFieldKey(key, "price_data"))
+	l.AdjustableQuantity.AppendFormValues(values, getFieldKey(key, "adjustable_quantity"))
+}
+
+// PriceData is used to create a Price inline
+type PriceData struct {
+	// Three-letter ISO currency code, in lowercase.
+	Currency string `json:"currency"`
+	// A non-negative integer in the smallest currency unit representing how much to charge. One of UnitAmount or UnitAmountDecimal is required.
+	UnitAmount *int64 `json:"unit_amount"`
+	// Same as UnitAmount, but accepts a decimal value in the smallest currency unit with at most 12 decimal places.
+	UnitAmountDecimal *string `json:"unit_amount_decimal"`
+	// The ID of the product that this price will belong to. One of Product or ProductData is required.
+	Product *string `json:"product"`
+	// Data used to generate a new product object inline. One of Product or ProductData is required.
+	ProductData *ProductData `json:"product_data"`
+	// The recurring components of a price such as interval. Required for subscription mode. (Optional)
+	Recurring *PriceDataRecurring `json:"recurring"`
+	// Specifies whether the price is considered inclusive of taxes or exclusive of taxes. (Optional)
+	TaxBehavior *string `json:"tax_behavior"`
+}
+
+func (p *PriceData) AppendFormValues(values url.Values, key string) {
+	if p == nil {
+		return
+	}
+
+	setFormString(values, getFieldKey(key, "currency"), p.Currency)
+	setFormInt64Ptr(values, getFieldKey(key, "unit_amount"), p.UnitAmount)
+	setFormStringPtr(values, getFieldKey(key, "unit_amount_decimal"), p.UnitAmountDecimal)
+	setFormStringPtr(values, getFieldKey(key, "product"), p.Product)
+	setFormStringPtr(values, getFieldKey(key, "tax_behavior"), p.TaxBehavior)
+	p.ProductData.AppendFormValues(values, getFieldKey(key, "product_data"))
+	p.Recurring.AppendFormValues(values, getFieldKey(key, "recurring"))
+}
+
+// ProductData is used to create a product inline
+type ProductData struct {
+	// The product's name, meant to be displayable to the customer.
+	Name string `json:"name"`
+	// The product's description, meant to be displayable to the customer. (Optional)
+	Description *string `json:"description"`
+	// A list of up to 8 URLs of images for this product, meant to be displayable to the customer. (Optional)
+	Images []string `json:"images"`
+	// A tax code ID. (Optional)
+	TaxCode *string `json:"tax_code"`
+
+	Metadata Dictionary `json:"metadata"`
+}
+
+func (p *ProductData) AppendFormValues(values url.Values, key string) {
+	if p == nil {
+		return
+	}
+
+	setFormString(values, getFieldKey(key, "name"), p.Name)
+	setFormStringPtr(values, getFieldKey(key, "description"), p.Description)
+	setFormStringSlice(values, getFieldKey(key, "images"), p.Images)
+	setFormStringPtr(values, getFieldKey(key, "tax_code"), p.TaxCode)
+	p.Metadata.AppendFormValues(values, getFieldKey(key, "metadata"))
+}
+
+// PriceDataRecurring represents the recurring components of PriceData
+type PriceDataRecurring struct {
+	// Specifies billing frequency. Either day, week, month or year.
+	Interval string `json:"interval"`
+	// The number of intervals between subscription billings. (Optional)
+	IntervalCount *int64 `json:"interval_count"`
+}
+
+func (p *PriceDataRecurring) AppendFormValues(values url.Values, key string) {
+	if p == nil {
+		return
+	}
+
+	setFormString(values, getFieldKey(key, "interval"), p.Interval)
+	setFormInt64Ptr(values, getFieldKey(key, "interval_count"), p.IntervalCount)
+}
+
+// AdjustableQuantity allows the customer to adjust the quantity of a line item
+type AdjustableQuantity struct {
+	// Set to true if the quantity can be adjusted to any non-negative integer.
+	Enabled bool `json:"enabled"`
+	// The maximum quantity the customer can purchase. (Optional)
+	Maximum *int64 `json:"maximum"`
+	// The minimum quantity the customer must purchase. (Optional)
+	Minimum *int64 `json:"minimum"`
+}
+
+func (a *AdjustableQuantity) AppendFormValues(values url.Values, key string) {
+	if a == nil {
+		return
+	}
+
+	setFormBoolPtr(values, getFieldKey(key, "enabled"), &a.Enabled)
+	setFormInt64Ptr(values, getFieldKey(key, "maximum"), a.Maximum)
+	setFormInt64Ptr(values, getFieldKey(key, "minimum"), a.Minimum)
+}
+
+func appendLineItems(values url.Values, key string, items []LineItemRequest) {
+	for i := range items {
+		items[i].AppendFormValues(values, fmt.Sprintf("%s[%d]", key, i))
+	}
+}
@@ -0,0 +1,126 @@
+package stripe
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+const (
+	LogLevelDebug LogLevel = iota
+	LogLevelInfo
+	LogLevelWarn
+	LogLevelError
+)
+
+// LogLevel is the minimum level of the messages written by a Client to its Logger
+type LogLevel int
+
+// Logger is a leveled logger, it is satisfied by most logging libraries (such as logrus and zap's SugaredLogger)
+type Logger interface {
+	Debugf(format string, args ...interface{})
+	Infof(format string, args ...interface{})
+	Warnf(format string, args ...interface{})
+	Errorf(format string, args ...interface{})
+}
+
+// WithLogger will log every request performed by the Client at or above the provided level
+// Requests are logged at debug level, successful responses at info level and errors at error level
+// Card numbers, CVCs, API keys and client secrets are redacted from every message
+func WithLogger(logger Logger, level LogLevel) Option {
+	var l requestLogger
+	l.logger = logger
+	l.level = level
+
+	var m Middleware
+	m.BeforeRequest = l.beforeRequest
+	m.AfterResponse = l.afterResponse
+	m.OnError = l.onError
+	return WithMiddleware(m)
+}
+
+// NewStdLogger returns a Logger which writes to a standard library logger, prefixing each message with its level
+func NewStdLogger(l *log.Logger) Logger {
+	var s stdLogger
+	s.l = l
+	return &s
+}
+
+type stdLogger struct {
+	l *log.Logger
+}
+
+func (s *stdLogger) Debugf(format string, args ...interface{}) {
+	s.l.Printf("[DEBUG] "+format, args...)
+}
+
+func (s *stdLogger) Infof(format string, args ...interface{}) {
+	s.l.Printf("[INFO] "+format, args...)
+}
+
+func (s *stdLogger) Warnf(format string, args ...interface{}) {
+	s.l.Printf("[WARN] "+format, args...)
+}
+
+func (s *stdLogger) Errorf(format string, args ...interface{}) {
+	s.l.Printf("[ERROR] "+format, args...)
+}
+
+type requestLogger struct {
+	logger Logger
+	level  LogLevel
+}
+
+func (r *requestLogger) beforeRequest(info RequestInfo) {
+	if r.level > LogLevelDebug {
+		return
+	}
+
+	msg := fmt.Sprintf("stripe: request %s %s%s form=<%s>", info.Method, info.Endpoint, formatAccount(info.AccountID), formatForm(info.Form))
+	r.logger.Debugf("%s", redactString(msg))
+}
+
+func (r *requestLogger) afterResponse(info ResponseInfo) {
+	if r.level > LogLevelInfo || info.StatusCode != 200 {
+		// Error responses are logged by onError
+		return
+	}
+
+	msg := fmt.Sprintf("stripe: response %s %s%s status=%d request_id=%s latency=%v",
+		info.Method, info.Endpoint, formatAccount(info.AccountID), info.StatusCode, info.RequestID, info.Latency)
+	r.logger.Infof("%s", redactString(msg))
+}
+
+func (r *requestLogger) onError(info ResponseInfo, err error) {
+	msg := fmt.Sprintf("stripe: error %s %s%s status=%d request_id=%s latency=%v error=<%v>",
+		info.Method, info.Endpoint, formatAccount(info.AccountID), info.StatusCode, info.RequestID, info.Latency, err)
+	r.logger.Errorf("%s", redactString(msg))
+}
+
+// formatForm will format form values as sorted key=value pairs, without escaping them
+func formatForm(form url.Values) string {
+	keys := make([]string, 0, len(form))
+	for key := range form {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+	pairs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		for _, value := range form[key] {
+			pairs = append(pairs, key+"="+value)
+		}
+	}
+
+	return strings.Join(pairs, " ")
+}
+
+func formatAccount(accountID string) string {
+	if len(accountID) == 0 {
+		return ""
+	}
+
+	return " account=" + accountID
+}
@@ -0,0 +1,144 @@
+package stripe
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"strings"
+	"testing"
+
+	"github.com/luxraise/stripe/stripetest"
+)
+
+func TestWithLogger(t *testing.T) {
+	s := stripetest.NewServer()
+	defer s.Close()
+
+	var buf bytes.Buffer
+	logger := NewStdLogger(log.New(&buf, "", 0))
+	c, err := New(stripetest.APIKey, WithHost(s.URL), WithLogger(logger, LogLevelDebug))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var customer Customer
+	customer.Description = String("Paid with 4242424242424242 using sk_test_abc123 and pi_123_secret_456")
+
+	var created Customer
+	if created, err = c.CreateCustomer(customer); err != nil {
+		t.Fatal(err)
+	}
+
+	var card Card
+	card.CardNumber = "4000000000000002"
+	card.CVC = String("987")
+	card.ExpirationMonth = 12
+	card.ExpirationYear = 2099
+	if _, err = c.AddCreditCard(created.ID, card); err == nil {
+		t.Fatal("expected error for declined card and received nil")
+	}
+
+	logged := buf.String()
+	for _, secret := range []string{"4242424242424242", "4000000000000002", "sk_test_abc123", "pi_123_secret_456", "cvc]=987"} {
+		if strings.Contains(logged, secret) {
+			t.Fatalf("invalid log output, expected <%s> to be redacted and received:\n%s", secret, logged)
+		}
+	}
+
+	for _, expected := range []string{"[DEBUG] stripe: request POST /customers", "[INFO] stripe: response POST /tokens status=200", "[ERROR] stripe: error POST /customers/" + created.ID + "/sources status=402", "card[number]=************0002"} {
+		if !strings.Contains(logged, expected) {
+			t.Fatalf("invalid log output, expected <%s> within:\n%s", expected, logged)
+		}
+	}
+
+	buf.Reset()
+	if c, err = New(stripetest.APIKey, WithHost(s.URL), WithLogger(logger, LogLevelError)); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = c.GetCustomer(created.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if buf.Len() > 0 {
+		t.Fatalf("invalid log output, expected nothing below error level and received:\n%s", buf.String())
+	}
+}
+
+func TestCard_redacted(t *testing.T) {
+	var card Card
+	card.ID = "card_123"
+	card.CardNumber = "4242424242424242"
+	card.CVC = String("987")
+
+	var token Token
+	token.Card = card
+	token.BankAccount = &BankAccount{AccountNumber: "000123456789", RoutingNumber: String("110000000")}
+
+	bs, err := json.Marshal(token.Redacted())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	outputs := []string{
+		fmt.Sprintf("%v", card),
+		fmt.Sprintf("%+v", card),
+		fmt.Sprintf("%#v", card),
+		fmt.Sprintf("%s", card),
+		card.String(),
+		fmt.Sprintf("%+v", token),
+		fmt.Sprintf("%+v", []Card{card}),
+		string(bs),
+	}
+
+	for _, output := range outputs {
+		for _, secret := range []string{"4242424242424242", "987", "000123456789", "110000000"} {
+			if strings.Contains(output, secret) {
+				t.Fatalf("invalid output, expected <%s> to be redacted and received <%s>", secret, output)
+			}
+		}
+
+		if !strings.Contains(output, "4242") {
+			t.Fatalf("invalid output, expected the last four digits to remain and received <%s>", output)
+		}
+	}
+}
+
+func TestCard_MarshalJSON(t *testing.T) {
+	var card Card
+	card.CardNumber = "4242424242424242"
+	card.CVC = String("987")
+
+	bs, err := json.Marshal(card)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// JSON encoding is not affected by redaction, only Redacted masks the card
+	for _, expected := range []string{`"number":"4242424242424242"`, `"cvc":"987"`} {
+		if !strings.Contains(string(bs), expected) {
+			t.Fatalf("invalid JSON, expected <%s> within <%s>", expected, bs)
+		}
+	}
+}
+
+// directiveRecorder records the directive rebuilt by formatDirective
+type directiveRecorder struct {
+	directive *string
+}
+
+func (d directiveRecorder) Format(f fmt.State, verb rune) {
+	*d.directive = formatDirective(f, verb)
+}
+
+func Test_formatDirective(t *testing.T) {
+	for _, directive := range []string{"%v", "%+v", "%#v", "%-10s", "%08.3f", "%.2s", "% d"} {
+		var rebuilt string
+		fmt.Fprintf(ioutil.Discard, directive, directiveRecorder{directive: &rebuilt})
+		if rebuilt != directive {
+			t.Fatalf("invalid directive, expected <%s> and received <%s>", directive, rebuilt)
+		}
+	}
+}
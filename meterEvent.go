@@ -0,0 +1,57 @@
+package stripe
+
+import (
+	"net/url"
+)
+
+// MeterEvent represents a usage event reported against a billing meter
+type MeterEvent struct {
+	Object string `json:"object"`
+
+	// The name of the meter event. Corresponds with the event_name field on a meter.
+	EventName string `json:"event_name"`
+	// A unique identifier for the event. Stripe uses it to de-duplicate events which are reported more than once.
+	Identifier string `json:"identifier"`
+	// The payload of the event. Contains the stripe_customer_id and value fields by default.
+	Payload Dictionary `json:"payload"`
+	// The time of the event.
+	Timestamp int64 `json:"timestamp"`
+
+	Livemode bool  `json:"livemode"`
+	Created  int64 `json:"created"`
+}
+
+// MeterEventRequest is used to report a MeterEvent
+type MeterEventRequest struct {
+	// The name of the meter event. Corresponds with the event_name field on a meter.
+	EventName string `json:"event_name"`
+	// The payload of the event. This must contain the stripe_customer_id and value fields, unless the meter has been configured with custom keys.
+	Payload Dictionary `json:"payload"`
+	// A unique identifier for the event. If not provided, one will be generated. Reporting an event with the same identifier twice will not double count it. (Optional)
+	Identifier *string `json:"identifier"`
+	// The time of the event, which must be within the past 35 calendar days or up to 5 minutes in the future. Defaults to the current time. (Optional)
+	Timestamp *int64 `json:"timestamp"`
+}
+
+func (m *MeterEventRequest) ToFormValues() (form url.Values) {
+	// Pre-allocate values with enough space for the default payload rows
+	form = make(url.Values, 5)
+	setFormString(form, "event_name", m.EventName)
+	setFormStringPtr(form, "identifier", m.Identifier)
+	setFormInt64Ptr(form, "timestamp", m.Timestamp)
+	m.Payload.AppendFormValues(form, "payload")
+	return
+}
+
+func (m *MeterEventRequest) idempotencyKey() string {
+	if m.Identifier == nil {
+		return ""
+	}
+
+	return *m.Identifier
+}
+
+func (c *Client) CreateMeterEvent(request MeterEventRequest) (created MeterEvent, err error) {
+	err = c.request("POST", endpointBillingMeterEvents, &request, &created)
+	return
+}
@@ -0,0 +1,96 @@
+package stripe
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Middleware hooks into every request performed by a Client, any of the hooks may be nil
+// Hooks are called synchronously, in the order the Middleware was added to the Client
+// Retried requests call the hooks for every attempt, see WithMaxRetries
+type Middleware struct {
+	// BeforeRequest is called before a request is sent
+	BeforeRequest func(info RequestInfo)
+	// AfterResponse is called once a response has been received, regardless of its status code
+	AfterResponse func(info ResponseInfo)
+	// OnError is called when a request fails, either within the transport, with an error status code or when a
+	// successful response cannot be decoded. Decoding errors are reported after AfterResponse has been called.
+	OnError func(info ResponseInfo, err error)
+}
+
+// RequestInfo describes a request performed by a Client
+type RequestInfo struct {
+	// HTTP method of the request
+	Method string
+	// Endpoint of the request, excluding the API version (e.g. /customers/cus_123)
+	Endpoint string
+	// Endpoint template of the request with IDs replaced by {id}, suitable for grouping requests (e.g. /customers/{id})
+	Route string
+	// Form values of the request with sensitive fields (such as card numbers) redacted, nil for file uploads and downloads
+	Form url.Values
+	// ID of the connected account the request is made on behalf of, if any
+	AccountID string
+	// Attempt number of the request, starting at 1 and incremented for every retry
+	Attempt int
+}
+
+// ResponseInfo describes the outcome of a request performed by a Client
+type ResponseInfo struct {
+	RequestInfo
+
+	// HTTP status code of the response, zero when no response was received
+	StatusCode int
+	// The Stripe request ID from the Request-Id header, if any
+	RequestID string
+	// Time taken from sending the request until the response headers were received
+	// Reading and decoding the response body is not included, as file downloads are streamed to the caller
+	Latency time.Duration
+}
+
+// WithMiddleware will add Middleware to the Client, it is called for every request after any previously added Middleware
+func WithMiddleware(m Middleware) Option {
+	return func(c *Client) (err error) {
+		c.middleware = append(c.middleware, m)
+		return
+	}
+}
+
+func newRequestInfo(req *http.Request, endpoint string, form url.Values, accountID string, attempt int) (info RequestInfo) {
+	info.Method = req.Method
+	info.Endpoint = strings.TrimPrefix(req.URL.Path, "/"+apiVersion)
+	info.Route = getRoute(endpoint)
+	info.Form = redactForm(form)
+	info.AccountID = accountID
+	info.Attempt = attempt
+	return
+}
+
+func (c *Client) beforeRequest(info RequestInfo) {
+	for _, m := range c.middleware {
+		if m.BeforeRequest != nil {
+			m.BeforeRequest(info)
+		}
+	}
+}
+
+func (c *Client) afterResponse(info ResponseInfo, err error) {
+	for _, m := range c.middleware {
+		if m.AfterResponse != nil && info.StatusCode != 0 {
+			m.AfterResponse(info)
+		}
+
+		if m.OnError != nil && err != nil {
+			m.OnError(info, err)
+		}
+	}
+}
+
+func (c *Client) onError(info ResponseInfo, err error) {
+	for _, m := range c.middleware {
+		if m.OnError != nil {
+			m.OnError(info, err)
+		}
+	}
+}
@@ -0,0 +1,138 @@
+package stripe
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/luxraise/stripe/stripetest"
+)
+
+func TestWithMiddleware(t *testing.T) {
+	var (
+		requests  []RequestInfo
+		responses []ResponseInfo
+		errs      []error
+	)
+
+	var m Middleware
+	m.BeforeRequest = func(info RequestInfo) { requests = append(requests, info) }
+	m.AfterResponse = func(info ResponseInfo) { responses = append(responses, info) }
+	m.OnError = func(info ResponseInfo, err error) { errs = append(errs, err) }
+
+	s := stripetest.NewServer()
+	defer s.Close()
+
+	c, err := New(stripetest.APIKey, WithHost(s.URL), WithMiddleware(m))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var card Card
+	card.CardNumber = "4242424242424242"
+	card.CVC = String("123")
+	card.ExpirationMonth = 12
+	card.ExpirationYear = 2099
+	if _, err = c.CreateCardToken(card); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = c.GetCustomer("cus_123"); err == nil {
+		t.Fatal("expected error for missing customer and received nil")
+	}
+
+	if len(requests) != 2 || len(responses) != 2 || len(errs) != 1 {
+		t.Fatalf("invalid number of hook calls, received %d requests, %d responses and %d errors", len(requests), len(responses), len(errs))
+	}
+
+	tcs := []struct {
+		key      string
+		expected string
+	}{
+		{key: "card[number]", expected: "************4242"},
+		{key: "card[cvc]", expected: redacted},
+		{key: "card[exp_month]", expected: "12"},
+	}
+
+	for _, tc := range tcs {
+		if value := requests[0].Form.Get(tc.key); value != tc.expected {
+			t.Fatalf("invalid value for <%s>, expected <%s> and received <%s>", tc.key, tc.expected, value)
+		}
+	}
+
+	switch {
+	case requests[0].Method != "POST" || requests[0].Endpoint != "/tokens":
+		t.Fatalf("invalid request, expected <POST /tokens> and received <%s %s>", requests[0].Method, requests[0].Endpoint)
+	case requests[1].Endpoint != "/customers/cus_123":
+		t.Fatalf("invalid endpoint, expected <%s> and received <%s>", "/customers/cus_123", requests[1].Endpoint)
+	case responses[0].StatusCode != 200 || responses[1].StatusCode != 404:
+		t.Fatalf("invalid status codes, expected 200 and 404 and received %d and %d", responses[0].StatusCode, responses[1].StatusCode)
+	case len(responses[0].RequestID) == 0:
+		t.Fatal("invalid request ID, expected a value and received none")
+	case responses[0].Attempt != 1:
+		t.Fatalf("invalid attempt, expected %d and received %d", 1, responses[0].Attempt)
+	case errs[0] != err:
+		t.Fatalf("invalid error, expected <%v> and received <%v>", err, errs[0])
+	}
+}
+
+func Test_redactForm(t *testing.T) {
+	form := url.Values{
+		"card[number]":                             {"4242424242424242"},
+		"external_account[account_number]":         {"000123456789"},
+		"external_account[routing_number]":         {"110000000"},
+		"individual[id_number]":                    {"000000000"},
+		"individual[ssn_last_4]":                   {"0000"},
+		"pii[personal_id_number]":                  {"000000000"},
+		"individual[verification][document][back]": {"file_123"},
+	}
+
+	tcs := []struct {
+		key      string
+		expected string
+	}{
+		{key: "card[number]", expected: "************4242"},
+		{key: "external_account[account_number]", expected: "********6789"},
+		{key: "external_account[routing_number]", expected: "*****0000"},
+		{key: "individual[id_number]", expected: redacted},
+		{key: "individual[ssn_last_4]", expected: redacted},
+		{key: "pii[personal_id_number]", expected: redacted},
+		{key: "individual[verification][document][back]", expected: "file_123"},
+	}
+
+	redactedForm := redactForm(form)
+	for _, tc := range tcs {
+		if value := redactedForm.Get(tc.key); value != tc.expected {
+			t.Fatalf("invalid value for <%s>, expected <%s> and received <%s>", tc.key, tc.expected, value)
+		}
+	}
+}
+
+func TestWithMiddleware_decode_error(t *testing.T) {
+	s, _ := newFormServer(t, `not json`)
+	defer s.Close()
+
+	var (
+		responses int
+		errs      []error
+	)
+
+	var m Middleware
+	m.AfterResponse = func(info ResponseInfo) { responses++ }
+	m.OnError = func(info ResponseInfo, err error) { errs = append(errs, err) }
+
+	c, err := New("sk_test_123", WithHost(s.URL), WithMiddleware(m))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = c.GetCustomer("cus_123"); err == nil {
+		t.Fatal("expected error for invalid JSON and received nil")
+	}
+
+	switch {
+	case responses != 1:
+		t.Fatalf("invalid number of responses, expected %d and received %d", 1, responses)
+	case len(errs) != 1 || errs[0] != err:
+		t.Fatalf("invalid errors, expected <%v> and received %v", err, errs)
+	}
+}
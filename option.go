@@ -0,0 +1,33 @@
+package stripe
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// Option configures a Client during initialization
+type Option func(c *Client) error
+
+// WithHost will point the Client at a different API host, such as a fake server used for testing
+// The host is used for both API requests and file uploads
+func WithHost(host string) Option {
+	return func(c *Client) (err error) {
+		var u *url.URL
+		if u, err = url.Parse(host); err != nil {
+			return fmt.Errorf("error parsing host: %v", err)
+		}
+
+		c.u = u
+		c.uploadURL = u
+		return
+	}
+}
+
+// WithTransport will set the http.RoundTripper used to perform requests, such as a recording transport used for testing
+func WithTransport(transport http.RoundTripper) Option {
+	return func(c *Client) (err error) {
+		c.hc.Transport = transport
+		return
+	}
+}
@@ -0,0 +1,81 @@
+package stripe
+
+const (
+	PaymentIntentStatusRequiresPaymentMethod = "requires_payment_method"
+	PaymentIntentStatusRequiresConfirmation  = "requires_confirmation"
+	PaymentIntentStatusRequiresAction        = "requires_action"
+	PaymentIntentStatusProcessing            = "processing"
+	PaymentIntentStatusRequiresCapture       = "requires_capture"
+	PaymentIntentStatusCanceled              = "canceled"
+	PaymentIntentStatusSucceeded             = "succeeded"
+)
+
+// PaymentIntent represents the process of collecting a payment from a customer
+type PaymentIntent struct {
+	ID     string `json:"id"`
+	Object string `json:"object"`
+
+	// Amount intended to be collected by this PaymentIntent, in the smallest currency unit.
+	Amount int64 `json:"amount"`
+	// Amount that this PaymentIntent collects.
+	AmountReceived int64 `json:"amount_received"`
+	// Three-letter ISO currency code, in lowercase.
+	Currency string `json:"currency"`
+	// ID of the Customer this PaymentIntent belongs to, if one exists.
+	Customer *string `json:"customer"`
+	// An arbitrary string attached to the object.
+	Description *string `json:"description"`
+	// Status of this PaymentIntent, see the PaymentIntentStatus constants.
+	Status string `json:"status"`
+	// Controls when the funds will be captured from the customer's account, one of automatic, automatic_async or manual.
+	CaptureMethod string `json:"capture_method"`
+	// ID of the payment method used in this PaymentIntent.
+	PaymentMethod *string `json:"payment_method"`
+	// The ID of the latest charge created by this PaymentIntent.
+	LatestCharge *string `json:"latest_charge"`
+
+	Metadata Dictionary `json:"metadata"`
+	Livemode bool       `json:"livemode"`
+	Created  int64      `json:"created"`
+}
+
+// PaymentIntentSearchResult is a paginated list of PaymentIntents matching a search query
+type PaymentIntentSearchResult struct {
+	SearchResult
+	Data []PaymentIntent `json:"data"`
+}
+
+// PaymentIntentIterator iterates over every PaymentIntent of a paginated list
+type PaymentIntentIterator struct {
+	*Iterator
+}
+
+// PaymentIntent returns the PaymentIntent the iterator is currently at
+func (p *PaymentIntentIterator) PaymentIntent() (paymentIntent PaymentIntent) {
+	paymentIntent, _ = p.Current().(PaymentIntent)
+	return
+}
+
+func (c *Client) SearchPaymentIntents(params SearchParams) (result PaymentIntentSearchResult, err error) {
+	err = c.request("GET", endpointPaymentIntentsSearch, &params, &result)
+	return
+}
+
+// IteratePaymentIntentSearch will return an iterator over every PaymentIntent matching the search query, fetching pages as needed
+func (c *Client) IteratePaymentIntentSearch(params SearchParams) *PaymentIntentIterator {
+	fetch := func(params SearchParams) (page []interface{}, result SearchResult, err error) {
+		var resp PaymentIntentSearchResult
+		if resp, err = c.SearchPaymentIntents(params); err != nil {
+			return
+		}
+
+		for _, paymentIntent := range resp.Data {
+			page = append(page, paymentIntent)
+		}
+
+		result = resp.SearchResult
+		return
+	}
+
+	return &PaymentIntentIterator{newSearchIterator(params, fetch)}
+}
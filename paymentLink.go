@@ -0,0 +1,179 @@
+package stripe
+
+import (
+	"net/url"
+)
+
+const (
+	PaymentLinkAfterCompletionHostedConfirmation = "hosted_confirmation"
+	PaymentLinkAfterCompletionRedirect           = "redirect"
+)
+
+// PaymentLink represents a shareable URL that takes customers to a hosted payment page
+type PaymentLink struct {
+	ID     string `json:"id"`
+	Object string `json:"object"`
+
+	// Whether the payment link's URL is active. If false, customers visiting the URL will be shown a page saying that the link has been deactivated.
+	Active bool `json:"active"`
+	// The public URL that can be shared with customers.
+	URL string `json:"url"`
+	// Three-letter ISO currency code, in lowercase.
+	Currency string `json:"currency"`
+	// Behavior after the purchase is complete.
+	AfterCompletion PaymentLinkAfterCompletion `json:"after_completion"`
+	// Whether user redeemable promotion codes are enabled.
+	AllowPromotionCodes bool `json:"allow_promotion_codes"`
+	// Settings that restrict the usage of a payment link.
+	Restrictions *PaymentLinkRestrictions `json:"restrictions"`
+	// The line items representing what is being sold, only present when expanded.
+	LineItems *LineItemList `json:"line_items"`
+
+	Metadata Dictionary `json:"metadata"`
+	Livemode bool       `json:"livemode"`
+}
+
+// PaymentLinkAfterCompletion represents the behavior after a PaymentLink purchase is complete
+type PaymentLinkAfterCompletion struct {
+	// The specified behavior after the purchase is complete, either hosted_confirmation or redirect.
+	Type string `json:"type"`
+	// Configuration when Type is hosted_confirmation. (Optional)
+	HostedConfirmation *PaymentLinkHostedConfirmation `json:"hosted_confirmation"`
+	// Configuration when Type is redirect.
+	Redirect *PaymentLinkRedirect `json:"redirect"`
+}
+
+func (p *PaymentLinkAfterCompletion) AppendFormValues(values url.Values, key string) {
+	if p == nil {
+		return
+	}
+
+	setFormString(values, getFieldKey(key, "type"), p.Type)
+	if p.HostedConfirmation != nil {
+		setFormStringPtr(values, getFieldKey(getFieldKey(key, "hosted_confirmation"), "custom_message"), p.HostedConfirmation.CustomMessage)
+	}
+
+	if p.Redirect != nil {
+		setFormString(values, getFieldKey(getFieldKey(key, "redirect"), "url"), p.Redirect.URL)
+	}
+}
+
+// PaymentLinkHostedConfirmation configures the confirmation page shown after a purchase
+type PaymentLinkHostedConfirmation struct {
+	// A custom message to display to the customer after the purchase is complete.
+	CustomMessage *string `json:"custom_message"`
+}
+
+// PaymentLinkRedirect configures the redirect performed after a purchase
+type PaymentLinkRedirect struct {
+	// The URL the customer will be redirected to after the purchase is complete.
+	URL string `json:"url"`
+}
+
+// PaymentLinkRestrictions represent the settings that restrict the usage of a PaymentLink
+type PaymentLinkRestrictions struct {
+	// Configuration for the completed_sessions restriction type.
+	CompletedSessions PaymentLinkCompletedSessions `json:"completed_sessions"`
+}
+
+func (p *PaymentLinkRestrictions) AppendFormValues(values url.Values, key string) {
+	if p == nil {
+		return
+	}
+
+	setFormInt64(values, getFieldKey(getFieldKey(key, "completed_sessions"), "limit"), p.CompletedSessions.Limit)
+}
+
+// PaymentLinkCompletedSessions limits the number of checkout sessions which can complete for a PaymentLink
+type PaymentLinkCompletedSessions struct {
+	// The current number of checkout sessions that have been completed on the payment link which count towards the limit.
+	Count int64 `json:"count"`
+	// The maximum number of checkout sessions that can be completed for the completed_sessions restriction to be met.
+	Limit int64 `json:"limit"`
+}
+
+// PaymentLinkRequest is used to create or update a PaymentLink
+type PaymentLinkRequest struct {
+	// The line items representing what is being sold. Each line item requires a Price on creation, and an ID on update.
+	LineItems []LineItemRequest `json:"line_items"`
+	// Whether the payment link's URL is active. Only used on update. (Optional)
+	Active *bool `json:"active"`
+	// Three-letter ISO currency code, in lowercase. Only used on creation. (Optional)
+	Currency *string `json:"currency"`
+	// Behavior after the purchase is complete. (Optional)
+	AfterCompletion *PaymentLinkAfterCompletion `json:"after_completion"`
+	// Enables user redeemable promotion codes. (Optional)
+	AllowPromotionCodes *bool `json:"allow_promotion_codes"`
+	// Settings that restrict the usage of a payment link. (Optional)
+	Restrictions *PaymentLinkRestrictions `json:"restrictions"`
+
+	Metadata Dictionary `json:"metadata"`
+}
+
+func (p *PaymentLinkRequest) ToFormValues() (form url.Values) {
+	// Pre-allocate values with enough space for a single line item link
+	form = make(url.Values, 3)
+	setFormBoolPtr(form, "active", p.Active)
+	setFormStringPtr(form, "currency", p.Currency)
+	setFormBoolPtr(form, "allow_promotion_codes", p.AllowPromotionCodes)
+	appendLineItems(form, "line_items", p.LineItems)
+	p.AfterCompletion.AppendFormValues(form, "after_completion")
+	p.Restrictions.AppendFormValues(form, "restrictions")
+	p.Metadata.AppendFormValues(form, "metadata")
+	return
+}
+
+// PaymentLinkListRequest is used to list PaymentLinks
+type PaymentLinkListRequest struct {
+	ListParams
+
+	// Only return payment links that are active or inactive. (Optional)
+	Active *bool `json:"active"`
+}
+
+func (p *PaymentLinkListRequest) ToFormValues() (form url.Values) {
+	form = p.ListParams.ToFormValues()
+	setFormBoolPtr(form, "active", p.Active)
+	return
+}
+
+// PaymentLinkList is a paginated list of PaymentLinks
+type PaymentLinkList struct {
+	List
+	Data []PaymentLink `json:"data"`
+}
+
+func (c *Client) CreatePaymentLink(request PaymentLinkRequest) (created PaymentLink, err error) {
+	err = c.request("POST", endpointPaymentLinks, &request, &created)
+	return
+}
+
+func (c *Client) GetPaymentLink(paymentLinkID string) (paymentLink PaymentLink, err error) {
+	err = c.request("GET", endpointPaymentLinksWithID, nil, &paymentLink, paymentLinkID)
+	return
+}
+
+func (c *Client) UpdatePaymentLink(paymentLinkID string, request PaymentLinkRequest) (updated PaymentLink, err error) {
+	// The currency can only be provided on creation
+	request.Currency = nil
+	err = c.request("POST", endpointPaymentLinksWithID, &request, &updated, paymentLinkID)
+	return
+}
+
+// DeactivatePaymentLink will deactivate a PaymentLink, customers visiting the URL will be told the link has been deactivated
+func (c *Client) DeactivatePaymentLink(paymentLinkID string) (updated PaymentLink, err error) {
+	var req PaymentLinkRequest
+	req.Active = Bool(false)
+	err = c.request("POST", endpointPaymentLinksWithID, &req, &updated, paymentLinkID)
+	return
+}
+
+func (c *Client) ListPaymentLinks(request PaymentLinkListRequest) (list PaymentLinkList, err error) {
+	err = c.request("GET", endpointPaymentLinks, &request, &list)
+	return
+}
+
+func (c *Client) ListPaymentLinkLineItems(paymentLinkID string, params ListParams) (list LineItemList, err error) {
+	err = c.request("GET", endpointPaymentLinkLineItemsWithID, &params, &list, paymentLinkID)
+	return
+}
@@ -0,0 +1,81 @@
+package stripe
+
+import (
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+func TestPaymentLinkRequest_ToFormValues(t *testing.T) {
+	var req PaymentLinkRequest
+	req.Currency = String("usd")
+	req.AllowPromotionCodes = Bool(true)
+	req.LineItems = []LineItemRequest{
+		{Price: String("price_123"), Quantity: Int64(1)},
+		{Price: String("price_456"), Quantity: Int64(2), TaxRates: []string{"txr_123"}},
+	}
+	req.AfterCompletion = &PaymentLinkAfterCompletion{
+		Type:     PaymentLinkAfterCompletionRedirect,
+		Redirect: &PaymentLinkRedirect{URL: "https://example.com/thanks"},
+	}
+	req.Restrictions = &PaymentLinkRestrictions{CompletedSessions: PaymentLinkCompletedSessions{Limit: 10}}
+
+	wanted := url.Values{
+		"currency":                                {"usd"},
+		"allow_promotion_codes":                   {"true"},
+		"line_items[0][price]":                    {"price_123"},
+		"line_items[0][quantity]":                 {"1"},
+		"line_items[1][price]":                    {"price_456"},
+		"line_items[1][quantity]":                 {"2"},
+		"line_items[1][tax_rates][0]":             {"txr_123"},
+		"after_completion[type]":                  {"redirect"},
+		"after_completion[redirect][url]":         {"https://example.com/thanks"},
+		"restrictions[completed_sessions][limit]": {"10"},
+	}
+
+	if form := req.ToFormValues(); !reflect.DeepEqual(wanted, form) {
+		t.Fatalf("invalid form values, expected %v and received %v", wanted, form)
+	}
+}
+
+func TestPaymentLinkAfterCompletion_hosted_confirmation(t *testing.T) {
+	var req PaymentLinkRequest
+	req.AfterCompletion = &PaymentLinkAfterCompletion{
+		Type:               PaymentLinkAfterCompletionHostedConfirmation,
+		HostedConfirmation: &PaymentLinkHostedConfirmation{CustomMessage: String("Thanks for your order!")},
+	}
+
+	wanted := url.Values{
+		"after_completion[type]":                                {"hosted_confirmation"},
+		"after_completion[hosted_confirmation][custom_message]": {"Thanks for your order!"},
+	}
+
+	if form := req.ToFormValues(); !reflect.DeepEqual(wanted, form) {
+		t.Fatalf("invalid form values, expected %v and received %v", wanted, form)
+	}
+}
+
+func TestClient_UpdatePaymentLink(t *testing.T) {
+	s, form := newFormServer(t, `{"id":"plink_123","object":"payment_link"}`)
+	defer s.Close()
+
+	var req PaymentLinkRequest
+	req.Currency = String("usd")
+	req.Active = Bool(true)
+	req.LineItems = []LineItemRequest{{ID: String("li_123"), Quantity: Int64(3)}}
+
+	c := newTestServerClient(t, s.URL)
+	if _, err := c.UpdatePaymentLink("plink_123", req); err != nil {
+		t.Fatal(err)
+	}
+
+	wanted := url.Values{
+		"active":                  {"true"},
+		"line_items[0][id]":       {"li_123"},
+		"line_items[0][quantity]": {"3"},
+	}
+
+	if sent := form(); !reflect.DeepEqual(wanted, sent) {
+		t.Fatalf("invalid form values, expected %v and received %v", wanted, sent)
+	}
+}
@@ -0,0 +1,222 @@
+package stripe
+
+import (
+	"net/url"
+)
+
+const (
+	PayoutMethodStandard = "standard"
+	PayoutMethodInstant  = "instant"
+
+	PayoutStatusPaid      = "paid"
+	PayoutStatusPending   = "pending"
+	PayoutStatusInTransit = "in_transit"
+	PayoutStatusCanceled  = "canceled"
+	PayoutStatusFailed    = "failed"
+)
+
+// Payout represents funds being sent from a Stripe balance to a bank account or debit card
+type Payout struct {
+	ID     string `json:"id"`
+	Object string `json:"object"`
+
+	// The amount (in cents (or local equivalent)) that transfers to your bank account or debit card.
+	Amount int64 `json:"amount"`
+	// Three-letter ISO currency code, in lowercase.
+	Currency string `json:"currency"`
+	// Date that you can expect the payout to arrive in the bank.
+	ArrivalDate int64 `json:"arrival_date"`
+	// Returns true if the payout is created by an automated payout schedule and false if it's requested manually.
+	Automatic bool `json:"automatic"`
+	// ID of the balance transaction that describes the impact of this payout on your account balance.
+	BalanceTransaction *string `json:"balance_transaction"`
+	// ID of the bank account or card the payout is sent to.
+	Destination *string `json:"destination"`
+	// The method used to send this payout, which can be standard or instant.
+	Method string `json:"method"`
+	// The source balance this payout came from, which can be one of the following: card, fpx, or bank_account.
+	SourceType string `json:"source_type"`
+	// Extra information about a payout that displays on the user's bank statement.
+	StatementDescriptor *string `json:"statement_descriptor"`
+	// Current status of the payout: paid, pending, in_transit, canceled or failed.
+	Status string `json:"status"`
+	// Can be bank_account or card.
+	Type string `json:"type"`
+	// An arbitrary string attached to the object. Often useful for displaying to users.
+	Description *string `json:"description"`
+
+	// If the payout fails or cancels, this is the ID of the balance transaction that reverses the initial balance transaction.
+	FailureBalanceTransaction *string `json:"failure_balance_transaction"`
+	// Error code that provides a reason for a payout failure, if available.
+	FailureCode *string `json:"failure_code"`
+	// Message that provides the reason for a payout failure, if available.
+	FailureMessage *string `json:"failure_message"`
+	// If the payout reverses another, this is the ID of the original payout.
+	OriginalPayout *string `json:"original_payout"`
+	// If the payout reverses, this is the ID of the payout that reverses this payout.
+	ReversedBy *string `json:"reversed_by"`
+
+	Metadata Dictionary `json:"metadata"`
+	Livemode bool       `json:"livemode"`
+	Created  int64      `json:"created"`
+}
+
+// PayoutRequest is used to create a Payout
+type PayoutRequest struct {
+	// A positive integer in cents representing how much to payout.
+	Amount int64 `json:"amount"`
+	// Three-letter ISO currency code, in lowercase.
+	Currency string `json:"currency"`
+	// The ID of a bank account or a card to send the payout to. If you don't provide a destination, we use the default external account for the specified currency. (Optional)
+	Destination *string `json:"destination"`
+	// The method used to send this payout, which is standard or instant. (Optional)
+	Method *string `json:"method"`
+	// The balance type of your Stripe balance to draw this payout from, one of bank_account, card, or fpx. (Optional)
+	SourceType *string `json:"source_type"`
+	// A string that displays on the recipient's bank or card statement (up to 22 characters). (Optional)
+	StatementDescriptor *string `json:"statement_descriptor"`
+	// An arbitrary string attached to the object. Often useful for displaying to users. (Optional)
+	Description *string `json:"description"`
+
+	Metadata Dictionary `json:"metadata"`
+}
+
+func (p *PayoutRequest) ToFormValues() (form url.Values) {
+	// Pre-allocate values with enough space for the basic payout rows
+	form = make(url.Values, 3)
+	setFormInt64(form, "amount", p.Amount)
+	setFormString(form, "currency", p.Currency)
+	setFormStringPtr(form, "destination", p.Destination)
+	setFormStringPtr(form, "method", p.Method)
+	setFormStringPtr(form, "source_type", p.SourceType)
+	setFormStringPtr(form, "statement_descriptor", p.StatementDescriptor)
+	setFormStringPtr(form, "description", p.Description)
+	p.Metadata.AppendFormValues(form, "metadata")
+	return
+}
+
+// PayoutListRequest is used to list Payouts
+type PayoutListRequest struct {
+	ListParams
+
+	// Only return payouts that have the given status: pending, paid, failed, or canceled. (Optional)
+	Status *string `json:"status"`
+	// The ID of an external account - only return payouts sent to this external account. (Optional)
+	Destination *string `json:"destination"`
+	// Only return payouts that are expected to arrive during the given date interval. (Optional)
+	ArrivalDate *RangeQuery `json:"arrival_date"`
+	// Only return payouts that were created during the given date interval. (Optional)
+	Created *RangeQuery `json:"created"`
+}
+
+func (p *PayoutListRequest) ToFormValues() (form url.Values) {
+	form = p.ListParams.ToFormValues()
+	setFormStringPtr(form, "status", p.Status)
+	setFormStringPtr(form, "destination", p.Destination)
+	p.ArrivalDate.AppendFormValues(form, "arrival_date")
+	p.Created.AppendFormValues(form, "created")
+	return
+}
+
+// PayoutList is a paginated list of Payouts
+type PayoutList struct {
+	List
+	Data []Payout `json:"data"`
+}
+
+// PayoutIterator iterates over every Payout of a paginated list
+type PayoutIterator struct {
+	*Iterator
+}
+
+// Payout returns the Payout the iterator is currently at
+func (p *PayoutIterator) Payout() (payout Payout) {
+	payout, _ = p.Current().(Payout)
+	return
+}
+
+// payoutMetadataRequest is used to update the metadata of a Payout, or of the reversal of a Payout
+type payoutMetadataRequest struct {
+	Metadata Dictionary `json:"metadata"`
+}
+
+func (p *payoutMetadataRequest) ToFormValues() (form url.Values) {
+	form = make(url.Values, len(p.Metadata))
+	p.Metadata.AppendFormValues(form, "metadata")
+	return
+}
+
+func (c *Client) CreatePayout(request PayoutRequest) (created Payout, err error) {
+	err = c.request("POST", endpointPayouts, &request, &created)
+	return
+}
+
+func (c *Client) GetPayout(payoutID string) (payout Payout, err error) {
+	err = c.request("GET", endpointPayoutsWithID, nil, &payout, payoutID)
+	return
+}
+
+// UpdatePayout will update the metadata of a Payout, no other fields can be updated
+func (c *Client) UpdatePayout(payoutID string, metadata Dictionary) (updated Payout, err error) {
+	var req payoutMetadataRequest
+	req.Metadata = metadata
+	err = c.request("POST", endpointPayoutsWithID, &req, &updated, payoutID)
+	return
+}
+
+func (c *Client) ListPayouts(request PayoutListRequest) (list PayoutList, err error) {
+	err = c.request("GET", endpointPayouts, &request, &list)
+	return
+}
+
+// IteratePayouts will return an iterator over every Payout matching the request, fetching pages as needed
+func (c *Client) IteratePayouts(request PayoutListRequest) *PayoutIterator {
+	fetch := func(params ListParams) (page []interface{}, lastID string, hasMore bool, err error) {
+		request.ListParams = params
+
+		var list PayoutList
+		if list, err = c.ListPayouts(request); err != nil {
+			return
+		}
+
+		for _, payout := range list.Data {
+			page = append(page, payout)
+			lastID = payout.ID
+		}
+
+		hasMore = list.HasMore
+		return
+	}
+
+	return &PayoutIterator{newIterator(request.ListParams, fetch)}
+}
+
+// CancelPayout will cancel a pending Payout, the funds are refunded to your available balance
+func (c *Client) CancelPayout(payoutID string) (canceled Payout, err error) {
+	err = c.request("POST", endpointPayoutsCancelWithID, nil, &canceled, payoutID)
+	return
+}
+
+// ReversePayout will reverse a paid Payout by creating a new payout with the opposite amount
+func (c *Client) ReversePayout(payoutID string, metadata Dictionary) (reversal Payout, err error) {
+	var req payoutMetadataRequest
+	req.Metadata = metadata
+	err = c.request("POST", endpointPayoutsReverseWithID, &req, &reversal, payoutID)
+	return
+}
+
+// ListPayoutBalanceTransactions will list the BalanceTransactions which were paid out by a Payout
+func (c *Client) ListPayoutBalanceTransactions(payoutID string, params ListParams) (list BalanceTransactionList, err error) {
+	var req BalanceTransactionListRequest
+	req.ListParams = params
+	req.Payout = String(payoutID)
+	return c.ListBalanceTransactions(req)
+}
+
+// IteratePayoutBalanceTransactions will return an iterator over every BalanceTransaction which was paid out by a Payout
+func (c *Client) IteratePayoutBalanceTransactions(payoutID string, params ListParams) *BalanceTransactionIterator {
+	var req BalanceTransactionListRequest
+	req.ListParams = params
+	req.Payout = String(payoutID)
+	return c.IterateBalanceTransactions(req)
+}
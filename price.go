@@ -0,0 +1,87 @@
+package stripe
+
+const (
+	PriceTypeOneTime   = "one_time"
+	PriceTypeRecurring = "recurring"
+
+	PriceUsageTypeLicensed = "licensed"
+	PriceUsageTypeMetered  = "metered"
+)
+
+// Price represents the unit cost, currency, and (optional) billing cycle of a product
+type Price struct {
+	ID     string `json:"id"`
+	Object string `json:"object"`
+
+	// Whether the price can be used for new purchases.
+	Active bool `json:"active"`
+	// Three-letter ISO currency code, in lowercase.
+	Currency string `json:"currency"`
+	// The ID of the product this price is associated with.
+	Product string `json:"product"`
+	// One of one_time or recurring depending on whether the price is for a one-time purchase or a recurring (subscription) purchase.
+	Type string `json:"type"`
+	// The unit amount in the smallest currency unit to be charged
+	UnitAmount *int64 `json:"unit_amount"`
+	// A brief description of the price, hidden from customers.
+	Nickname *string `json:"nickname"`
+	// The recurring components of a price such as interval and usage_type.
+	Recurring *PriceRecurring `json:"recurring"`
+
+	Metadata Dictionary `json:"metadata"`
+	Livemode bool       `json:"livemode"`
+	Created  int64      `json:"created"`
+}
+
+// PriceRecurring represents the recurring components of a Price
+type PriceRecurring struct {
+	// The frequency at which a subscription is billed. One of day, week, month or year.
+	Interval string `json:"interval"`
+	// The number of intervals between subscription billings.
+	IntervalCount int64 `json:"interval_count"`
+	// Configures how the quantity per period should be determined. Can be either metered or licensed.
+	UsageType string `json:"usage_type"`
+	// Specifies a usage aggregation strategy for prices of usage_type=metered.
+	AggregateUsage *string `json:"aggregate_usage"`
+}
+
+// PriceSearchResult is a paginated list of Prices matching a search query
+type PriceSearchResult struct {
+	SearchResult
+	Data []Price `json:"data"`
+}
+
+// PriceIterator iterates over every Price of a paginated list
+type PriceIterator struct {
+	*Iterator
+}
+
+// Price returns the Price the iterator is currently at
+func (p *PriceIterator) Price() (price Price) {
+	price, _ = p.Current().(Price)
+	return
+}
+
+func (c *Client) SearchPrices(params SearchParams) (result PriceSearchResult, err error) {
+	err = c.request("GET", endpointPricesSearch, &params, &result)
+	return
+}
+
+// IteratePriceSearch will return an iterator over every Price matching the search query, fetching pages as needed
+func (c *Client) IteratePriceSearch(params SearchParams) *PriceIterator {
+	fetch := func(params SearchParams) (page []interface{}, result SearchResult, err error) {
+		var resp PriceSearchResult
+		if resp, err = c.SearchPrices(params); err != nil {
+			return
+		}
+
+		for _, price := range resp.Data {
+			page = append(page, price)
+		}
+
+		result = resp.SearchResult
+		return
+	}
+
+	return &PriceIterator{newSearchIterator(params, fetch)}
+}
@@ -0,0 +1,66 @@
+package stripe
+
+// Product represents a good or service offered to customers
+type Product struct {
+	ID     string `json:"id"`
+	Object string `json:"object"`
+
+	// Whether the product is currently available for purchase.
+	Active bool `json:"active"`
+	// The product's name, meant to be displayable to the customer.
+	Name string `json:"name"`
+	// The product's description, meant to be displayable to the customer.
+	Description *string `json:"description"`
+	// The ID of the Price object that is the default price for this product.
+	DefaultPrice *string `json:"default_price"`
+	// A list of up to 8 URLs of images for this product, meant to be displayable to the customer.
+	Images []string `json:"images"`
+	// A URL of a publicly-accessible webpage for this product.
+	URL *string `json:"url"`
+
+	Metadata Dictionary `json:"metadata"`
+	Livemode bool       `json:"livemode"`
+	Created  int64      `json:"created"`
+	Updated  int64      `json:"updated"`
+}
+
+// ProductSearchResult is a paginated list of Products matching a search query
+type ProductSearchResult struct {
+	SearchResult
+	Data []Product `json:"data"`
+}
+
+// ProductIterator iterates over every Product of a paginated list
+type ProductIterator struct {
+	*Iterator
+}
+
+// Product returns the Product the iterator is currently at
+func (p *ProductIterator) Product() (product Product) {
+	product, _ = p.Current().(Product)
+	return
+}
+
+func (c *Client) SearchProducts(params SearchParams) (result ProductSearchResult, err error) {
+	err = c.request("GET", endpointProductsSearch, &params, &result)
+	return
+}
+
+// IterateProductSearch will return an iterator over every Product matching the search query, fetching pages as needed
+func (c *Client) IterateProductSearch(params SearchParams) *ProductIterator {
+	fetch := func(params SearchParams) (page []interface{}, result SearchResult, err error) {
+		var resp ProductSearchResult
+		if resp, err = c.SearchProducts(params); err != nil {
+			return
+		}
+
+		for _, product := range resp.Data {
+			page = append(page, product)
+		}
+
+		result = resp.SearchResult
+		return
+	}
+
+	return &ProductIterator{newSearchIterator(params, fetch)}
+}
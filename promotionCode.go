@@ -0,0 +1,150 @@
+package stripe
+
+import (
+	"net/url"
+)
+
+// PromotionCode represents a customer-redeemable code for a Coupon
+type PromotionCode struct {
+	ID     string `json:"id"`
+	Object string `json:"object"`
+
+	// The customer-facing code. Regardless of case, this code must be unique across all active promotion codes for each customer.
+	Code string `json:"code"`
+	// The coupon for this promotion code.
+	Coupon Coupon `json:"coupon"`
+	// Whether the promotion code is currently active.
+	Active bool `json:"active"`
+	// The customer that this promotion code can be used by.
+	Customer *string `json:"customer"`
+	// Date at which the promotion code can no longer be redeemed.
+	ExpiresAt *int64 `json:"expires_at"`
+	// Maximum number of times this promotion code can be redeemed.
+	MaxRedemptions *int64 `json:"max_redemptions"`
+	// Settings that restrict the redemption of the promotion code.
+	Restrictions PromotionCodeRestrictions `json:"restrictions"`
+	// Number of times this promotion code has been used.
+	TimesRedeemed int64 `json:"times_redeemed"`
+
+	Metadata Dictionary `json:"metadata"`
+	Livemode bool       `json:"livemode"`
+	Created  int64      `json:"created"`
+}
+
+// PromotionCodeRestrictions represent the settings that restrict the redemption of a PromotionCode
+type PromotionCodeRestrictions struct {
+	// A Boolean indicating if the Promotion Code should only be redeemed for Customers without any successful payments or invoices.
+	FirstTimeTransaction *bool `json:"first_time_transaction"`
+	// Minimum amount required to redeem this Promotion Code into a Coupon (e.g., a purchase must be $100 or more to work).
+	MinimumAmount *int64 `json:"minimum_amount"`
+	// Three-letter ISO code for minimum_amount.
+	MinimumAmountCurrency *string `json:"minimum_amount_currency"`
+}
+
+func (p *PromotionCodeRestrictions) AppendFormValues(values url.Values, key string) {
+	if p == nil {
+		return
+	}
+
+	setFormBoolPtr(values, getFieldKey(key, "first_time_transaction"), p.FirstTimeTransaction)
+	setFormInt64Ptr(values, getFieldKey(key, "minimum_amount"), p.MinimumAmount)
+	setFormStringPtr(values, getFieldKey(key, "minimum_amount_currency"), p.MinimumAmountCurrency)
+}
+
+// PromotionCodeRequest is used to create or update a PromotionCode
+// Only Active, Restrictions and Metadata are used when updating
+type PromotionCodeRequest struct {
+	// The coupon for this promotion code. Required on creation.
+	Coupon string `json:"coupon"`
+	// The customer-facing code. If left blank, we will generate one automatically. (Optional)
+	Code *string `json:"code"`
+	// Whether the promotion code is currently active. (Optional)
+	Active *bool `json:"active"`
+	// The customer that this promotion code can be used by. If not set, the promotion code can be used by all customers. (Optional)
+	Customer *string `json:"customer"`
+	// The timestamp at which this promotion code will expire. (Optional)
+	ExpiresAt *int64 `json:"expires_at"`
+	// A positive integer specifying the number of times the promotion code can be redeemed. (Optional)
+	MaxRedemptions *int64 `json:"max_redemptions"`
+	// Settings that restrict the redemption of the promotion code. (Optional)
+	Restrictions *PromotionCodeRestrictions `json:"restrictions"`
+
+	Metadata Dictionary `json:"metadata"`
+}
+
+func (p *PromotionCodeRequest) ToFormValues() (form url.Values) {
+	// Pre-allocate values with enough space for the basic promotion code rows
+	form = make(url.Values, 3)
+	setFormString(form, "coupon", p.Coupon)
+	setFormStringPtr(form, "code", p.Code)
+	setFormBoolPtr(form, "active", p.Active)
+	setFormStringPtr(form, "customer", p.Customer)
+	setFormInt64Ptr(form, "expires_at", p.ExpiresAt)
+	setFormInt64Ptr(form, "max_redemptions", p.MaxRedemptions)
+	p.Restrictions.AppendFormValues(form, "restrictions")
+	p.Metadata.AppendFormValues(form, "metadata")
+	return
+}
+
+// PromotionCodeListRequest is used to list PromotionCodes
+type PromotionCodeListRequest struct {
+	ListParams
+
+	// Filter promotion codes by whether they are active. (Optional)
+	Active *bool `json:"active"`
+	// Only return promotion codes that have this case-insensitive code. (Optional)
+	Code *string `json:"code"`
+	// Only return promotion codes for this coupon. (Optional)
+	Coupon *string `json:"coupon"`
+	// Only return promotion codes that are restricted to this customer. (Optional)
+	Customer *string `json:"customer"`
+}
+
+func (p *PromotionCodeListRequest) ToFormValues() (form url.Values) {
+	form = p.ListParams.ToFormValues()
+	setFormBoolPtr(form, "active", p.Active)
+	setFormStringPtr(form, "code", p.Code)
+	setFormStringPtr(form, "coupon", p.Coupon)
+	setFormStringPtr(form, "customer", p.Customer)
+	return
+}
+
+// PromotionCodeList is a paginated list of PromotionCodes
+type PromotionCodeList struct {
+	List
+	Data []PromotionCode `json:"data"`
+}
+
+func (c *Client) CreatePromotionCode(request PromotionCodeRequest) (created PromotionCode, err error) {
+	err = c.request("POST", endpointPromotionCodes, &request, &created)
+	return
+}
+
+func (c *Client) GetPromotionCode(promotionCodeID string) (promotionCode PromotionCode, err error) {
+	err = c.request("GET", endpointPromotionCodesWithID, nil, &promotionCode, promotionCodeID)
+	return
+}
+
+func (c *Client) UpdatePromotionCode(promotionCodeID string, request PromotionCodeRequest) (updated PromotionCode, err error) {
+	// Only active, restrictions and metadata of a promotion code can be updated
+	var req PromotionCodeRequest
+	req.Active = request.Active
+	req.Restrictions = request.Restrictions
+	req.Metadata = request.Metadata
+
+	err = c.request("POST", endpointPromotionCodesWithID, &req, &updated, promotionCodeID)
+	return
+}
+
+// DeactivatePromotionCode will deactivate a PromotionCode, promotion codes cannot be deleted
+func (c *Client) DeactivatePromotionCode(promotionCodeID string) (updated PromotionCode, err error) {
+	var req PromotionCodeRequest
+	req.Active = Bool(false)
+	err = c.request("POST", endpointPromotionCodesWithID, &req, &updated, promotionCodeID)
+	return
+}
+
+func (c *Client) ListPromotionCodes(request PromotionCodeListRequest) (list PromotionCodeList, err error) {
+	err = c.request("GET", endpointPromotionCodes, &request, &list)
+	return
+}
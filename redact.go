@@ -0,0 +1,97 @@
+package stripe
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const redacted = "[REDACTED]"
+
+// redactForm returns a copy of the form values with sensitive fields, such as card numbers and CVCs, redacted
+func redactForm(form url.Values) (redactedForm url.Values) {
+	if form == nil {
+		return
+	}
+
+	redactedForm = make(url.Values, len(form))
+	for key, values := range form {
+		field := getField(key)
+		redactedValues := make([]string, len(values))
+		for i, value := range values {
+			redactedValues[i] = redactValue(field, value)
+		}
+
+		redactedForm[key] = redactedValues
+	}
+
+	return
+}
+
+func redactValue(field, value string) string {
+	switch field {
+	case "number", "account_number", "routing_number":
+		return maskNumber(value)
+	case "cvc", "client_secret", "id_number", "personal_id_number", "ssn_last_4":
+		return redacted
+	default:
+		return value
+	}
+}
+
+// maskNumber will mask every character of a card or account number except the last four
+func maskNumber(number string) string {
+	if len(number) <= 4 {
+		return strings.Repeat("*", len(number))
+	}
+
+	return strings.Repeat("*", len(number)-4) + number[len(number)-4:]
+}
+
+// getField returns the innermost field of a form key, e.g. card[number] returns number
+func getField(key string) string {
+	if !strings.HasSuffix(key, "]") {
+		return key
+	}
+
+	key = key[:len(key)-1]
+	return key[strings.LastIndex(key, "[")+1:]
+}
+
+var (
+	// cardNumberPattern matches anything which looks like a card number (PAN)
+	cardNumberPattern = regexp.MustCompile(`\b\d{13,19}\b`)
+	// apiKeyPattern matches secret, restricted and publishable API keys
+	apiKeyPattern = regexp.MustCompile(`\b((?:sk|rk|pk)_(?:test|live)_)[0-9A-Za-z]+`)
+	// clientSecretPattern matches the client secrets of PaymentIntents, SetupIntents and Checkout Sessions
+	clientSecretPattern = regexp.MustCompile(`\b[a-z]+_[0-9A-Za-z]+_secret_[0-9A-Za-z]+`)
+)
+
+// redactString will mask card numbers, API keys and client secrets found anywhere within a string
+func redactString(str string) string {
+	str = cardNumberPattern.ReplaceAllStringFunc(str, maskNumber)
+	str = apiKeyPattern.ReplaceAllString(str, "${1}"+redacted)
+	return clientSecretPattern.ReplaceAllString(str, redacted)
+}
+
+// formatDirective rebuilds the formatting directive of a fmt.Formatter call, such as %+v
+func formatDirective(f fmt.State, verb rune) string {
+	directive := "%"
+	for _, flag := range "+-# 0" {
+		if f.Flag(int(flag)) {
+			directive += string(flag)
+		}
+	}
+
+	if width, ok := f.Width(); ok {
+		directive += strconv.Itoa(width)
+	}
+
+	if precision, ok := f.Precision(); ok {
+		directive += "." + strconv.Itoa(precision)
+	}
+
+	return directive + string(verb)
+}
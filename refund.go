@@ -28,6 +28,12 @@ type RefundRequest struct {
 
 	// String indicating the reason for the refund. If set, possible values are duplicate, fraudulent, and requested_by_customer. If you believe the charge to be fraudulent, specifying fraudulent as the reason will add the associated card and email to your block lists, and will also help us improve our fraud detection algorithms.
 	Reason *string `json:"reason"`
+
+	// Boolean indicating whether the transfer should be reversed when refunding this charge. The transfer will be reversed proportionally to the amount being refunded. Only used for destination charges.
+	ReverseTransfer *bool `json:"reverse_transfer"`
+
+	// Boolean indicating whether the application fee should be refunded when refunding this charge. If a full charge refund is given, the full application fee will be refunded. Otherwise, the application fee will be refunded in an amount proportional to the amount of the charge refunded.
+	RefundApplicationFee *bool `json:"refund_application_fee"`
 }
 
 func (r *RefundRequest) ToFormValues() (form url.Values) {
@@ -37,6 +43,8 @@ func (r *RefundRequest) ToFormValues() (form url.Values) {
 	setFormInt64(form, "amount", r.Amount)
 	setFormStringPtr(form, "payment_intent", r.PaymentIntent)
 	setFormStringPtr(form, "reason", r.Reason)
+	setFormBoolPtr(form, "reverse_transfer", r.ReverseTransfer)
+	setFormBoolPtr(form, "refund_application_fee", r.RefundApplicationFee)
 	r.Metadata.AppendFormValues(form, "metadata")
 	return
 }
@@ -0,0 +1,84 @@
+package stripe
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+const (
+	// defaultRetryDelay is the delay before the first retry of a request, it doubles with every following attempt
+	defaultRetryDelay = 500 * time.Millisecond
+	// maxRetryDelay is the longest delay between two attempts of a request
+	maxRetryDelay = 8 * time.Second
+)
+
+// WithMaxRetries will retry requests which fail with a connection error, or a 409, 429 or 5xx status code
+// Requests are retried up to the provided number of times with an exponential backoff, and are not retried by default
+// POST requests are sent with an Idempotency-Key when retries are enabled, so retrying never applies a request twice
+// File uploads are streamed and are never retried
+func WithMaxRetries(retries int) Option {
+	return func(c *Client) (err error) {
+		if retries < 0 {
+			return fmt.Errorf("invalid max retries, expected a positive value and received %d", retries)
+		}
+
+		c.maxRetries = retries
+		return
+	}
+}
+
+// waitToRetry will wait before the next attempt of a request, backing off exponentially
+func (c *Client) waitToRetry(ctx context.Context, attempt int) (err error) {
+	delay := c.retryDelay << uint(attempt-1)
+	if delay > maxRetryDelay {
+		delay = maxRetryDelay
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return c.wrapError(fmt.Errorf("error performing request: %w", ctx.Err()))
+	case <-timer.C:
+		return
+	}
+}
+
+// canRetry returns whether a request can safely be sent again
+// POST requests need an idempotency key, and the body must be able to be read again
+func canRetry(req *http.Request) bool {
+	if req.Method == "POST" && len(req.Header.Get("Idempotency-Key")) == 0 {
+		return false
+	}
+
+	return req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+}
+
+// shouldRetry returns whether a failed response is worth retrying, Stripe may decide with the Stripe-Should-Retry header
+func shouldRetry(resp *http.Response) bool {
+	switch resp.Header.Get("Stripe-Should-Retry") {
+	case "true":
+		return true
+	case "false":
+		return false
+	}
+
+	return resp.StatusCode == 409 || resp.StatusCode == 429 || resp.StatusCode >= 500
+}
+
+// rewindRequest returns a copy of a request with a fresh body, so it can be sent again
+func rewindRequest(req *http.Request) (rewound *http.Request, err error) {
+	rewound = req.Clone(req.Context())
+	if req.GetBody == nil {
+		return
+	}
+
+	if rewound.Body, err = req.GetBody(); err != nil {
+		err = fmt.Errorf("error rewinding request body: %v", err)
+	}
+
+	return
+}
@@ -0,0 +1,113 @@
+package stripe
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestWithMaxRetries(t *testing.T) {
+	var (
+		mux    sync.Mutex
+		keys   []string
+		bodies []string
+	)
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mux.Lock()
+		defer mux.Unlock()
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("error parsing form: %v", err)
+		}
+
+		keys = append(keys, r.Header.Get("Idempotency-Key"))
+		bodies = append(bodies, r.PostForm.Encode())
+		if len(keys) < 3 {
+			w.WriteHeader(503)
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"id":"cus_123","object":"customer"}`))
+	}))
+	defer s.Close()
+
+	var attempts []int
+	var m Middleware
+	m.BeforeRequest = func(info RequestInfo) { attempts = append(attempts, info.Attempt) }
+
+	c, err := New("sk_test_123", WithHost(s.URL), WithMaxRetries(2), WithMiddleware(m))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c.retryDelay = time.Millisecond
+	if _, err = c.CreateCustomer(Customer{Name: String("Leeroy Jenkins")}); err != nil {
+		t.Fatal(err)
+	}
+
+	mux.Lock()
+	defer mux.Unlock()
+	switch {
+	case len(attempts) != 3 || attempts[0] != 1 || attempts[2] != 3:
+		t.Fatalf("invalid attempts, expected %v and received %v", []int{1, 2, 3}, attempts)
+	case len(keys[0]) == 0 || keys[0] != keys[1] || keys[1] != keys[2]:
+		t.Fatalf("invalid idempotency keys, expected the same key for every attempt and received %v", keys)
+	case bodies[0] != "name=Leeroy+Jenkins" || bodies[0] != bodies[2]:
+		t.Fatalf("invalid bodies, expected the same body for every attempt and received %v", bodies)
+	}
+}
+
+func TestWithMaxRetries_status_codes(t *testing.T) {
+	tcs := []struct {
+		name        string
+		statusCode  int
+		shouldRetry string
+		retries     int
+		expected    int
+	}{
+		{name: "disabled", statusCode: 503, expected: 1},
+		{name: "server error", statusCode: 503, retries: 2, expected: 3},
+		{name: "rate limited", statusCode: 429, retries: 1, expected: 2},
+		{name: "not found", statusCode: 404, retries: 2, expected: 1},
+		{name: "retry header", statusCode: 400, shouldRetry: "true", retries: 1, expected: 2},
+		{name: "no retry header", statusCode: 503, shouldRetry: "false", retries: 2, expected: 1},
+	}
+
+	for _, tc := range tcs {
+		var (
+			mux   sync.Mutex
+			count int
+		)
+
+		s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			mux.Lock()
+			defer mux.Unlock()
+			count++
+			if len(tc.shouldRetry) > 0 {
+				w.Header().Set("Stripe-Should-Retry", tc.shouldRetry)
+			}
+
+			w.WriteHeader(tc.statusCode)
+			_, _ = w.Write([]byte(`{"error":{"type":"invalid_request_error","message":"No such customer"}}`))
+		}))
+
+		c, err := New("sk_test_123", WithHost(s.URL), WithMaxRetries(tc.retries))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		c.retryDelay = time.Millisecond
+		if _, err = c.GetCustomer("cus_123"); err == nil {
+			t.Fatalf("expected error for %s and received nil", tc.name)
+		}
+
+		s.Close()
+		mux.Lock()
+		if count != tc.expected {
+			t.Fatalf("invalid number of attempts for %s, expected %d and received %d", tc.name, tc.expected, count)
+		}
+		mux.Unlock()
+	}
+}
@@ -0,0 +1,59 @@
+package stripe
+
+import "net/url"
+
+// SearchParams represent the parameters shared by search endpoints
+// PaymentIntents, Products and Subscriptions only support search, they cannot be created or updated with this Client
+type SearchParams struct {
+	// The search query string. See the Stripe search query language documentation for details.
+	Query string `json:"query"`
+	// A limit on the number of objects to be returned. Limit can range between 1 and 100, and the default is 10.
+	Limit *int64 `json:"limit"`
+	// A cursor for pagination across multiple pages of results. Use the NextPage value returned in a previous response.
+	Page *string `json:"page"`
+}
+
+func (s *SearchParams) ToFormValues() (form url.Values) {
+	form = make(url.Values, 3)
+	setFormString(form, "query", s.Query)
+	setFormInt64Ptr(form, "limit", s.Limit)
+	setFormStringPtr(form, "page", s.Page)
+	return
+}
+
+// SearchResult represents the shared fields of a search response
+type SearchResult struct {
+	List
+
+	// Cursor for the next page of results, nil when there are no more results
+	NextPage *string `json:"next_page"`
+	// The total number of objects that match the query, only accurate up to 10,000
+	TotalCount *int64 `json:"total_count"`
+}
+
+// newSearchIterator initializes an Iterator over search results
+// Search results are paginated with the next_page token, which is carried as the StartingAfter cursor
+func newSearchIterator(params SearchParams, fetch searchPageFetcher) *Iterator {
+	var listParams ListParams
+	listParams.Limit = params.Limit
+	listParams.StartingAfter = params.Page
+	return newIterator(listParams, func(listParams ListParams) (page []interface{}, nextPage string, hasMore bool, err error) {
+		params.Limit = listParams.Limit
+		params.Page = listParams.StartingAfter
+
+		var result SearchResult
+		if page, result, err = fetch(params); err != nil {
+			return
+		}
+
+		if result.NextPage != nil {
+			nextPage = *result.NextPage
+		}
+
+		hasMore = result.HasMore
+		return
+	})
+}
+
+// searchPageFetcher will fetch a single page of search results for the provided search parameters
+type searchPageFetcher func(params SearchParams) (page []interface{}, result SearchResult, err error)
@@ -0,0 +1,94 @@
+package stripe
+
+import (
+	"strconv"
+	"strings"
+)
+
+// SearchClause is a single clause of a search query, such as email:"jenny@example.com"
+type SearchClause string
+
+// SearchField matches objects where the field exactly equals the value (case insensitive)
+func SearchField(field, value string) SearchClause {
+	return SearchClause(field + ":" + quoteSearchValue(value))
+}
+
+// SearchContains matches objects where the field contains the value as a substring
+func SearchContains(field, value string) SearchClause {
+	return SearchClause(field + "~" + quoteSearchValue(value))
+}
+
+// SearchMetadata matches objects where the metadata key exactly equals the value
+func SearchMetadata(key, value string) SearchClause {
+	return SearchField("metadata["+quoteSearchValue(key)+"]", value)
+}
+
+// SearchNumber matches objects where the numeric field equals the value
+func SearchNumber(field string, value int64) SearchClause {
+	return searchComparison(field, ":", value)
+}
+
+// SearchGreaterThan matches objects where the numeric field is greater than the value
+func SearchGreaterThan(field string, value int64) SearchClause {
+	return searchComparison(field, ">", value)
+}
+
+// SearchGreaterThanOrEqual matches objects where the numeric field is greater than or equal to the value
+func SearchGreaterThanOrEqual(field string, value int64) SearchClause {
+	return searchComparison(field, ">=", value)
+}
+
+// SearchLessThan matches objects where the numeric field is less than the value
+func SearchLessThan(field string, value int64) SearchClause {
+	return searchComparison(field, "<", value)
+}
+
+// SearchLessThanOrEqual matches objects where the numeric field is less than or equal to the value
+func SearchLessThanOrEqual(field string, value int64) SearchClause {
+	return searchComparison(field, "<=", value)
+}
+
+// SearchNot negates a clause, matching objects which do not match it
+func SearchNot(clause SearchClause) SearchClause {
+	if strings.HasPrefix(string(clause), "-") {
+		return clause[1:]
+	}
+
+	return "-" + clause
+}
+
+// SearchAnd joins clauses into a query matching objects which match every clause
+// Note: Stripe does not allow AND and OR to be combined within a single query
+func SearchAnd(clauses ...SearchClause) string {
+	return joinSearchClauses(clauses, " AND ")
+}
+
+// SearchOr joins clauses into a query matching objects which match any of the clauses
+// Note: Stripe does not allow AND and OR to be combined within a single query
+func SearchOr(clauses ...SearchClause) string {
+	return joinSearchClauses(clauses, " OR ")
+}
+
+func searchComparison(field, operator string, value int64) SearchClause {
+	return SearchClause(field + operator + strconv.FormatInt(value, 10))
+}
+
+func joinSearchClauses(clauses []SearchClause, separator string) string {
+	strs := make([]string, 0, len(clauses))
+	for _, clause := range clauses {
+		if len(clause) == 0 {
+			continue
+		}
+
+		strs = append(strs, string(clause))
+	}
+
+	return strings.Join(strs, separator)
+}
+
+// quoteSearchValue wraps a value in quotes, escaping any backslashes and quotes within it
+func quoteSearchValue(value string) string {
+	value = strings.ReplaceAll(value, `\`, `\\`)
+	value = strings.ReplaceAll(value, `"`, `\"`)
+	return `"` + value + `"`
+}
@@ -0,0 +1,181 @@
+package stripe
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestSearchQuery(t *testing.T) {
+	tcs := []struct {
+		query    string
+		expected string
+	}{
+		{
+			query:    SearchAnd(SearchField("email", "jenny@example.com"), SearchMetadata("order", "123")),
+			expected: `email:"jenny@example.com" AND metadata["order"]:"123"`,
+		},
+		{
+			query:    SearchOr(SearchContains("name", `Leeroy "The Legend"`), SearchField("name", `back\slash`)),
+			expected: `name~"Leeroy \"The Legend\"" OR name:"back\\slash"`,
+		},
+		{
+			query:    SearchAnd(SearchGreaterThan("amount", 1000), SearchLessThanOrEqual("created", 1600000000), SearchNumber("amount_refunded", 0)),
+			expected: `amount>1000 AND created<=1600000000 AND amount_refunded:0`,
+		},
+		{
+			query:    SearchAnd(SearchNot(SearchField("status", "failed")), SearchNot(SearchNot(SearchMetadata(`k"ey`, "v")))),
+			expected: `-status:"failed" AND metadata["k\"ey"]:"v"`,
+		},
+		{
+			query:    SearchAnd("", SearchGreaterThanOrEqual("amount", 1), SearchLessThan("amount", 5)),
+			expected: `amount>=1 AND amount<5`,
+		},
+	}
+
+	for _, tc := range tcs {
+		if tc.query != tc.expected {
+			t.Fatalf("invalid query, expected <%s> and received <%s>", tc.expected, tc.query)
+		}
+	}
+}
+
+func TestClient_IterateCustomerSearch(t *testing.T) {
+	var pages []string
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page := r.URL.Query().Get("page")
+		pages = append(pages, page)
+		switch page {
+		case "":
+			_, _ = w.Write([]byte(`{"object":"search_result","has_more":true,"next_page":"page_2","data":[{"id":"cus_1"},{"id":"cus_2"}]}`))
+		default:
+			_, _ = w.Write([]byte(`{"object":"search_result","has_more":false,"next_page":null,"data":[{"id":"cus_3"}]}`))
+		}
+	}))
+	defer s.Close()
+
+	c := newTestServerClient(t, s.URL)
+	it := c.IterateCustomerSearch(SearchParams{Query: SearchAnd(SearchField("email", "jenny@example.com"))})
+
+	var ids []string
+	for it.Next() {
+		ids = append(ids, it.Customer().ID)
+	}
+
+	if err := it.Err(); err != nil {
+		t.Fatal(err)
+	}
+
+	if wanted := []string{"cus_1", "cus_2", "cus_3"}; !reflect.DeepEqual(wanted, ids) {
+		t.Fatalf("invalid IDs, expected %v and received %v", wanted, ids)
+	}
+
+	if wanted := []string{"", "page_2"}; !reflect.DeepEqual(wanted, pages) {
+		t.Fatalf("invalid pages, expected %v and received %v", wanted, pages)
+	}
+}
+
+func TestClient_search_resources(t *testing.T) {
+	var paths []string
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		if r.URL.Query().Get("query") != `status:"active"` {
+			t.Errorf("invalid query, expected <%s> and received <%s>", `status:"active"`, r.URL.Query().Get("query"))
+		}
+
+		switch r.URL.Query().Get("page") {
+		case "":
+			_, _ = w.Write([]byte(`{"object":"search_result","has_more":true,"next_page":"page_2","data":[{"id":"obj_1"}]}`))
+		default:
+			_, _ = w.Write([]byte(`{"object":"search_result","has_more":false,"next_page":null,"data":[{"id":"obj_2"}]}`))
+		}
+	}))
+	defer s.Close()
+
+	c := newTestServerClient(t, s.URL)
+	params := SearchParams{Query: SearchAnd(SearchField("status", "active"))}
+
+	tcs := []struct {
+		path    string
+		iterate func() (ids []string, err error)
+	}{
+		{
+			path: "/v1/payment_intents/search",
+			iterate: func() (ids []string, err error) {
+				it := c.IteratePaymentIntentSearch(params)
+				for it.Next() {
+					ids = append(ids, it.PaymentIntent().ID)
+				}
+
+				return ids, it.Err()
+			},
+		},
+		{
+			path: "/v1/products/search",
+			iterate: func() (ids []string, err error) {
+				it := c.IterateProductSearch(params)
+				for it.Next() {
+					ids = append(ids, it.Product().ID)
+				}
+
+				return ids, it.Err()
+			},
+		},
+		{
+			path: "/v1/subscriptions/search",
+			iterate: func() (ids []string, err error) {
+				it := c.IterateSubscriptionSearch(params)
+				for it.Next() {
+					ids = append(ids, it.Subscription().ID)
+				}
+
+				return ids, it.Err()
+			},
+		},
+	}
+
+	for _, tc := range tcs {
+		paths = nil
+		ids, err := tc.iterate()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if wanted := []string{"obj_1", "obj_2"}; !reflect.DeepEqual(wanted, ids) {
+			t.Fatalf("invalid IDs for <%s>, expected %v and received %v", tc.path, wanted, ids)
+		}
+
+		if wanted := []string{tc.path, tc.path}; !reflect.DeepEqual(wanted, paths) {
+			t.Fatalf("invalid paths, expected %v and received %v", wanted, paths)
+		}
+	}
+}
+
+func TestSubscriptionSearchResult(t *testing.T) {
+	r := strings.NewReader(`{
+		"object": "search_result",
+		"has_more": false,
+		"next_page": null,
+		"data": [{
+			"id": "sub_123",
+			"object": "subscription",
+			"customer": "cus_123",
+			"status": "active",
+			"items": { "object": "list", "has_more": false, "data": [{ "id": "si_123", "price": { "id": "price_123" }, "quantity": 2 }] }
+		}]
+	}`)
+
+	var result SubscriptionSearchResult
+	if err := handleResponse(r, &result); err != nil {
+		t.Fatal(err)
+	}
+
+	switch {
+	case len(result.Data) != 1 || result.Data[0].Status != SubscriptionStatusActive:
+		t.Fatalf("invalid subscriptions, received %+v", result.Data)
+	case len(result.Data[0].Items.Data) != 1 || result.Data[0].Items.Data[0].Price.ID != "price_123":
+		t.Fatalf("invalid subscription items, received %+v", result.Data[0].Items)
+	}
+}
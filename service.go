@@ -0,0 +1,38 @@
+package stripe
+
+// CustomerService covers the Customer operations of a Client
+type CustomerService interface {
+	CreateCustomer(customer Customer) (created Customer, err error)
+	GetCustomer(stripeUserID string) (customer Customer, err error)
+	UpdateCustomer(stripeUserID string, customer Customer) (updated Customer, err error)
+	RemoveCustomer(stripeUserID string) (err error)
+}
+
+// CardService covers the Card operations of a Client
+type CardService interface {
+	AddCreditCard(stripeUserID string, card Card) (created Card, err error)
+	ListCards(stripeUserID string) (cards []Card, err error)
+	RemoveCreditCard(stripeUserID, cardID string) (err error)
+}
+
+// ChargeService covers the Charge operations of a Client
+type ChargeService interface {
+	CreateCharge(stripeUserID string, charge Charge) (created Charge, err error)
+}
+
+// RefundService covers the Refund operations of a Client
+type RefundService interface {
+	CreateRefund(request RefundRequest) (refund Refund, err error)
+}
+
+// PaymentService covers the customer, card, charge and refund operations of a Client
+// Depend on this (or one of the smaller interfaces) instead of *Client to substitute a fake, such as stripefake.Client, in tests
+type PaymentService interface {
+	CustomerService
+	CardService
+	ChargeService
+	RefundService
+}
+
+// Ensure Client satisfies every service interface
+var _ PaymentService = &Client{}
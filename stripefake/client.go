@@ -0,0 +1,480 @@
+// Package stripefake provides an in-memory implementation of stripe.PaymentService for unit tests
+//
+// No requests are made, customers, cards, charges and refunds are stored in memory. The invariants enforced by
+// Stripe are kept, such as a refund never exceeding the unrefunded amount of its charge. Test card numbers decline
+// the same way as they do with the stripetest server, either when they are added or when they are charged.
+package stripefake
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/luxraise/stripe"
+	"github.com/luxraise/stripe/stripetest"
+)
+
+const (
+	// DeclinedCardNumber is a test card number which is declined when it is added to a customer
+	DeclinedCardNumber = "4000000000000002"
+	// DeclinedOnChargeCardNumber is a test card number which can be added to a customer, but is declined when it is charged
+	DeclinedOnChargeCardNumber = "4000000000000341"
+)
+
+// Ensure Client satisfies the payment service interface
+var _ stripe.PaymentService = &Client{}
+
+// New initializes and returns a new, empty, fake Client
+func New() *Client {
+	var c Client
+	c.customers = make(map[string]*customerEntry)
+	c.charges = make(map[string]*chargeEntry)
+	c.cardDeclines = make(map[string]stripetest.Decline)
+	return &c
+}
+
+// Client is an in-memory fake of the customer, card, charge and refund operations of stripe.Client
+type Client struct {
+	mux sync.Mutex
+
+	customers map[string]*customerEntry
+	charges   map[string]*chargeEntry
+	// Declines of cards which fail once they are charged, keyed by card ID
+	cardDeclines map[string]stripetest.Decline
+	// Counter used to generate sequential IDs
+	seq int
+}
+
+type customerEntry struct {
+	customer stripe.Customer
+	cards    []stripe.Card
+	deleted  bool
+}
+
+type chargeEntry struct {
+	charge  stripe.Charge
+	refunds []stripe.Refund
+}
+
+func (c *Client) CreateCustomer(customer stripe.Customer) (created stripe.Customer, err error) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	customer = copyCustomer(customer)
+	customer.ID = c.newID("cus")
+	customer.Object = "customer"
+	c.customers[customer.ID] = &customerEntry{customer: customer}
+	return copyCustomer(customer), nil
+}
+
+func (c *Client) GetCustomer(stripeUserID string) (customer stripe.Customer, err error) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	entry, ok := c.customers[stripeUserID]
+	switch {
+	case !ok:
+		err = newResourceMissingError("customer", stripeUserID)
+	case entry.deleted:
+		// Deleted customers can still be retrieved, only their ID remains
+		customer.ID = entry.customer.ID
+		customer.Object = entry.customer.Object
+	default:
+		customer = copyCustomer(entry.customer)
+	}
+
+	return
+}
+
+func (c *Client) UpdateCustomer(stripeUserID string, customer stripe.Customer) (updated stripe.Customer, err error) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	var entry *customerEntry
+	if entry, err = c.getCustomer(stripeUserID); err != nil {
+		return
+	}
+
+	if customer.DefaultSource != nil && findCard(entry.cards, *customer.DefaultSource) == -1 {
+		err = newResourceMissingError("source", *customer.DefaultSource)
+		return
+	}
+
+	mergeCustomer(&entry.customer, customer)
+	return copyCustomer(entry.customer), nil
+}
+
+func (c *Client) RemoveCustomer(stripeUserID string) (err error) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	var entry *customerEntry
+	if entry, err = c.getCustomer(stripeUserID); err != nil {
+		return
+	}
+
+	entry.deleted = true
+	entry.cards = nil
+	return
+}
+
+func (c *Client) AddCreditCard(stripeUserID string, card stripe.Card) (created stripe.Card, err error) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	if len(card.CardNumber) < 4 {
+		// Mirrors the error returned by stripe.Client when tokenization fails
+		err = fmt.Errorf("error creating card token: %v", newInvalidRequestError("card[number]", "Missing required param: card[number]."))
+		return
+	}
+
+	var entry *customerEntry
+	if entry, err = c.getCustomer(stripeUserID); err != nil {
+		return
+	}
+
+	decline, declined := stripetest.LookupDecline(card.CardNumber)
+	if declined && !decline.OnCharge {
+		// Cards are verified with the issuer when they are added to a customer
+		err = newCardError(decline)
+		return
+	}
+
+	created = card
+	created.ID = c.newID("card")
+	created.Object = "card"
+	created.LastFour = card.CardNumber[len(card.CardNumber)-4:]
+	// Full card numbers and security codes are never returned by Stripe
+	created.CardNumber = ""
+	created.CVC = nil
+
+	if declined {
+		c.cardDeclines[created.ID] = decline
+	}
+
+	entry.cards = append(entry.cards, created)
+	if entry.customer.DefaultSource == nil {
+		entry.customer.DefaultSource = stripe.String(created.ID)
+	}
+
+	return
+}
+
+func (c *Client) ListCards(stripeUserID string) (cards []stripe.Card, err error) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	var entry *customerEntry
+	if entry, err = c.getCustomer(stripeUserID); err != nil {
+		return
+	}
+
+	cards = append([]stripe.Card{}, entry.cards...)
+	return
+}
+
+func (c *Client) RemoveCreditCard(stripeUserID, cardID string) (err error) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	var entry *customerEntry
+	if entry, err = c.getCustomer(stripeUserID); err != nil {
+		return
+	}
+
+	index := findCard(entry.cards, cardID)
+	if index == -1 {
+		return newResourceMissingError("source", cardID)
+	}
+
+	entry.cards = append(entry.cards[:index], entry.cards[index+1:]...)
+	if entry.customer.DefaultSource != nil && *entry.customer.DefaultSource == cardID {
+		entry.customer.DefaultSource = nil
+		if len(entry.cards) > 0 {
+			entry.customer.DefaultSource = stripe.String(entry.cards[len(entry.cards)-1].ID)
+		}
+	}
+
+	return
+}
+
+func (c *Client) CreateCharge(stripeUserID string, charge stripe.Charge) (created stripe.Charge, err error) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	switch {
+	case charge.Amount <= 0:
+		err = newInvalidRequestError("amount", "Missing required param: amount.")
+		return
+	case len(charge.Currency) == 0:
+		err = newInvalidRequestError("currency", "Missing required param: currency.")
+		return
+	}
+
+	var entry *customerEntry
+	if entry, err = c.getCustomer(stripeUserID); err != nil {
+		return
+	}
+
+	source := string(charge.Source)
+	if len(source) == 0 && entry.customer.DefaultSource != nil {
+		source = *entry.customer.DefaultSource
+	}
+
+	if findCard(entry.cards, source) == -1 {
+		msg := fmt.Sprintf("Customer %s does not have a linked source with ID %s.", stripeUserID, source)
+		err = newInvalidRequestError("source", msg)
+		return
+	}
+
+	created = charge
+	created.ID = c.newID("ch")
+	created.Object = "charge"
+	created.StripeUserID = stripeUserID
+	created.Source = stripe.Source(source)
+	created.Metadata = copyDictionary(charge.Metadata)
+	if decline, ok := c.cardDeclines[source]; ok {
+		// Declined charges are still created, mirroring Stripe
+		c.charges[created.ID] = &chargeEntry{charge: created}
+		return stripe.Charge{}, newCardError(decline)
+	}
+
+	created.BalanceTransaction = c.newID("txn")
+	created.Captured = true
+	created.Paid = true
+	c.charges[created.ID] = &chargeEntry{charge: created}
+	return
+}
+
+// CreateRefund will refund a charge, the refund amount cannot exceed the amount of the charge which has not been refunded
+func (c *Client) CreateRefund(request stripe.RefundRequest) (refund stripe.Refund, err error) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	entry, ok := c.charges[request.Charge]
+	if !ok {
+		err = newResourceMissingError("charge", request.Charge)
+		return
+	}
+
+	remaining := entry.charge.Amount - entry.refunded()
+	switch {
+	case !entry.charge.Paid:
+		err = newInvalidRequestError("charge", fmt.Sprintf("Charge %s cannot be refunded because it has failed.", request.Charge))
+		return
+	case remaining == 0:
+		e := newInvalidRequestError("charge", fmt.Sprintf("Charge %s has already been refunded.", request.Charge))
+		e.Code = "charge_already_refunded"
+		err = e
+		return
+	case request.Amount <= 0:
+		err = newInvalidRequestError("amount", "This value must be greater than or equal to 1.")
+		return
+	case request.Amount > remaining:
+		msg := fmt.Sprintf("Refund amount ($%.2f) is greater than unrefunded amount on charge ($%.2f)", float64(request.Amount)/100, float64(remaining)/100)
+		err = newInvalidRequestError("amount", msg)
+		return
+	}
+
+	refund.ID = c.newID("re")
+	refund.Object = "refund"
+	refund.RefundRequest = request
+	refund.Metadata = copyDictionary(request.Metadata)
+	refund.Currency = entry.charge.Currency
+	refund.Status = stripe.RefundStatusSucceeded
+	entry.refunds = append(entry.refunds, refund)
+	return
+}
+
+// Customers returns every customer which has not been removed, ordered by ID
+func (c *Client) Customers() (customers []stripe.Customer) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	for _, entry := range c.customers {
+		if !entry.deleted {
+			customers = append(customers, copyCustomer(entry.customer))
+		}
+	}
+
+	sort.Slice(customers, func(i, j int) bool { return customers[i].ID < customers[j].ID })
+	return
+}
+
+// Charges returns every charge made to a customer, in the order they were created
+func (c *Client) Charges(stripeUserID string) (charges []stripe.Charge) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	for _, entry := range c.charges {
+		if entry.charge.StripeUserID == stripeUserID {
+			charges = append(charges, entry.charge)
+		}
+	}
+
+	sort.Slice(charges, func(i, j int) bool { return charges[i].ID < charges[j].ID })
+	return
+}
+
+// Refunds returns every refund of a charge, in the order they were created
+func (c *Client) Refunds(chargeID string) (refunds []stripe.Refund) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	if entry, ok := c.charges[chargeID]; ok {
+		refunds = append(refunds, entry.refunds...)
+	}
+
+	return
+}
+
+func (c *Client) getCustomer(stripeUserID string) (entry *customerEntry, err error) {
+	var ok bool
+	if entry, ok = c.customers[stripeUserID]; !ok || entry.deleted {
+		return nil, newResourceMissingError("customer", stripeUserID)
+	}
+
+	return
+}
+
+// newID returns a sequential ID with the provided prefix, IDs sort in the order they were created
+func (c *Client) newID(prefix string) string {
+	c.seq++
+	return fmt.Sprintf("%s_fake%08d", prefix, c.seq)
+}
+
+func (e *chargeEntry) refunded() (amount int64) {
+	for _, refund := range e.refunds {
+		amount += refund.Amount
+	}
+
+	return
+}
+
+// mergeCustomer applies the set fields of an update to a customer, mirroring the partial updates of the Stripe API
+// Values are copied, so the update can be modified by the caller without affecting the stored customer
+func mergeCustomer(customer *stripe.Customer, update stripe.Customer) {
+	setString(&customer.Name, update.Name)
+	setString(&customer.Description, update.Description)
+	setString(&customer.Email, update.Email)
+	setString(&customer.Phone, update.Phone)
+	setString(&customer.DefaultSource, update.DefaultSource)
+	setString(&customer.InvoicePrefix, update.InvoicePrefix)
+	setString(&customer.TaxExempt, update.TaxExempt)
+
+	if update.Balance != nil {
+		customer.Balance = copyInt64(update.Balance)
+	}
+
+	if update.Address != nil {
+		address := *update.Address
+		customer.Address = &address
+	}
+
+	if len(update.Currency) > 0 {
+		customer.Currency = update.Currency
+	}
+
+	if len(update.PreferredLocales) > 0 {
+		customer.PreferredLocales = append([]string(nil), update.PreferredLocales...)
+	}
+
+	if customer.Metadata == nil && len(update.Metadata) > 0 {
+		customer.Metadata = make(stripe.Dictionary, len(update.Metadata))
+	}
+
+	for key, value := range update.Metadata {
+		if len(value) == 0 {
+			// Empty values unset metadata keys
+			delete(customer.Metadata, key)
+			continue
+		}
+
+		customer.Metadata[key] = value
+	}
+}
+
+// copyCustomer returns a copy of a customer which shares no pointers, maps or slices with the original
+func copyCustomer(customer stripe.Customer) (copied stripe.Customer) {
+	copied = customer
+	copied.Name = copyString(customer.Name)
+	copied.Description = copyString(customer.Description)
+	copied.Email = copyString(customer.Email)
+	copied.DefaultSource = copyString(customer.DefaultSource)
+	copied.Phone = copyString(customer.Phone)
+	copied.InvoicePrefix = copyString(customer.InvoicePrefix)
+	copied.TaxExempt = copyString(customer.TaxExempt)
+	copied.Coupon = copyString(customer.Coupon)
+	copied.PromotionCode = copyString(customer.PromotionCode)
+	copied.Balance = copyInt64(customer.Balance)
+	copied.NextInvoiceSequence = copyInt64(customer.NextInvoiceSequence)
+	copied.Metadata = copyDictionary(customer.Metadata)
+	copied.Shipping = copyDictionary(customer.Shipping)
+	copied.PreferredLocales = append([]string(nil), customer.PreferredLocales...)
+	copied.TaxIDData = append([]stripe.TaxIDData(nil), customer.TaxIDData...)
+	if customer.Address != nil {
+		address := *customer.Address
+		copied.Address = &address
+	}
+
+	return
+}
+
+func setString(field **string, value *string) {
+	if value != nil {
+		*field = copyString(value)
+	}
+}
+
+func copyString(value *string) *string {
+	if value == nil {
+		return nil
+	}
+
+	copied := *value
+	return &copied
+}
+
+func copyInt64(value *int64) *int64 {
+	if value == nil {
+		return nil
+	}
+
+	copied := *value
+	return &copied
+}
+
+func findCard(cards []stripe.Card, cardID string) int {
+	for i, card := range cards {
+		if card.ID == cardID {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func copyDictionary(d stripe.Dictionary) (copied stripe.Dictionary) {
+	if d == nil {
+		return
+	}
+
+	copied = make(stripe.Dictionary, len(d))
+	for key, value := range d {
+		copied[key] = value
+	}
+
+	return
+}
+
+func newCardError(d stripetest.Decline) *stripe.Error {
+	return &stripe.Error{Type: "card_error", Code: d.Code, DeclineCode: d.DeclineCode, Message: d.Message}
+}
+
+func newInvalidRequestError(param, message string) *stripe.Error {
+	return &stripe.Error{Type: "invalid_request_error", Param: param, Message: message}
+}
+
+func newResourceMissingError(object, id string) *stripe.Error {
+	return &stripe.Error{Type: "invalid_request_error", Code: "resource_missing", Message: fmt.Sprintf("No such %s: '%s'", object, id)}
+}
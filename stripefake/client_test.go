@@ -0,0 +1,175 @@
+package stripefake
+
+import (
+	"testing"
+
+	"github.com/luxraise/stripe"
+)
+
+func TestClient_refund_invariant(t *testing.T) {
+	var svc stripe.PaymentService = New()
+
+	customer, err := svc.CreateCustomer(stripe.Customer{Name: stripe.String("Leeroy Jenkins")})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var card stripe.Card
+	card.CardNumber = "4242424242424242"
+	card.ExpirationMonth = 11
+	card.ExpirationYear = 2099
+
+	created, err := svc.AddCreditCard(customer.ID, card)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if created.LastFour != "4242" || len(created.CardNumber) != 0 {
+		t.Fatalf("invalid card, expected last four of <%s> and no card number and received %+v", "4242", created)
+	}
+
+	var charge stripe.Charge
+	charge.Amount = 1337
+	charge.Currency = "usd"
+
+	createdCharge, err := svc.CreateCharge(customer.ID, charge)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(createdCharge.Source) != created.ID {
+		t.Fatalf("invalid source, expected default source of <%s> and received <%s>", created.ID, createdCharge.Source)
+	}
+
+	tcs := []struct {
+		amount int64
+		fails  bool
+	}{
+		{amount: 1000},
+		{amount: 338, fails: true},
+		{amount: 337},
+		{amount: 1, fails: true},
+	}
+
+	for _, tc := range tcs {
+		_, err = svc.CreateRefund(stripe.RefundRequest{Charge: createdCharge.ID, Amount: tc.amount})
+		if (err != nil) != tc.fails {
+			t.Fatalf("invalid refund result for amount %d, expected failure %v and received <%v>", tc.amount, tc.fails, err)
+		}
+
+		if _, ok := err.(*stripe.Error); tc.fails && !ok {
+			t.Fatalf("invalid error, expected *stripe.Error and received %T", err)
+		}
+	}
+}
+
+func TestClient_customer_cycle(t *testing.T) {
+	c := New()
+
+	customer, err := c.CreateCustomer(stripe.Customer{Name: stripe.String("Leeroy"), Metadata: stripe.Dictionary{"foo": "bar"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var update stripe.Customer
+	update.Email = stripe.String("leeroy@example.com")
+	update.Metadata = stripe.Dictionary{"foo": ""}
+
+	updated, err := c.UpdateCustomer(customer.ID, update)
+	switch {
+	case err != nil:
+		t.Fatal(err)
+	case *updated.Name != "Leeroy":
+		t.Fatalf("invalid name, expected <%s> and received <%s>", "Leeroy", *updated.Name)
+	case *updated.Email != "leeroy@example.com":
+		t.Fatalf("invalid email, expected <%s> and received <%s>", "leeroy@example.com", *updated.Email)
+	case len(updated.Metadata) != 0:
+		t.Fatalf("invalid metadata, expected no keys and received %v", updated.Metadata)
+	}
+
+	if _, err = c.AddCreditCard(customer.ID, stripe.Card{CardNumber: DeclinedCardNumber}); err == nil {
+		t.Fatal("expected error for declined card and received nil")
+	}
+
+	if err = c.RemoveCustomer(customer.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	removed, err := c.GetCustomer(customer.ID)
+	switch {
+	case err != nil:
+		t.Fatal(err)
+	case removed.Name != nil:
+		t.Fatalf("invalid name, expected <nil> and received <%s>", *removed.Name)
+	case len(c.Customers()) != 0:
+		t.Fatalf("invalid number of customers, expected %d and received %d", 0, len(c.Customers()))
+	}
+}
+
+func TestClient_charge_decline(t *testing.T) {
+	c := New()
+
+	customer, err := c.CreateCustomer(stripe.Customer{Name: stripe.String("Leeroy")})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The card is attached, and is only declined once it is charged
+	if _, err = c.AddCreditCard(customer.ID, stripe.Card{CardNumber: DeclinedOnChargeCardNumber}); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = c.CreateCharge(customer.ID, stripe.Charge{Amount: 1337, Currency: "usd"})
+	stripeErr, ok := err.(*stripe.Error)
+	switch {
+	case !ok:
+		t.Fatalf("invalid error, expected *stripe.Error and received <%v>", err)
+	case stripeErr.Code != "card_declined" || stripeErr.DeclineCode != "generic_decline":
+		t.Fatalf("invalid error, expected <%s/%s> and received <%s/%s>", "card_declined", "generic_decline", stripeErr.Code, stripeErr.DeclineCode)
+	}
+
+	charges := c.Charges(customer.ID)
+	if len(charges) != 1 || charges[0].Paid {
+		t.Fatalf("invalid charges, expected a single unpaid charge and received %+v", charges)
+	}
+
+	if _, err = c.CreateRefund(stripe.RefundRequest{Charge: charges[0].ID}); err == nil {
+		t.Fatal("expected error for refunding a failed charge and received nil")
+	}
+}
+
+func TestClient_UpdateCustomer_copies_values(t *testing.T) {
+	c := New()
+
+	customer, err := c.CreateCustomer(stripe.Customer{Name: stripe.String("Leeroy")})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var update stripe.Customer
+	update.Email = stripe.String("leeroy@example.com")
+	update.PreferredLocales = []string{"en"}
+	if _, err = c.UpdateCustomer(customer.ID, update); err != nil {
+		t.Fatal(err)
+	}
+
+	*update.Email = "jenkins@example.com"
+	update.PreferredLocales[0] = "fr"
+
+	stored, err := c.GetCustomer(customer.ID)
+	switch {
+	case err != nil:
+		t.Fatal(err)
+	case *stored.Email != "leeroy@example.com":
+		t.Fatalf("invalid email, expected <%s> and received <%s>", "leeroy@example.com", *stored.Email)
+	case stored.PreferredLocales[0] != "en":
+		t.Fatalf("invalid preferred locales, expected %v and received %v", []string{"en"}, stored.PreferredLocales)
+	}
+
+	*stored.Name = "Jenkins"
+	if stored, err = c.GetCustomer(customer.ID); err != nil {
+		t.Fatal(err)
+	} else if *stored.Name != "Leeroy" {
+		t.Fatalf("invalid name, expected <%s> and received <%s>", "Leeroy", *stored.Name)
+	}
+}
@@ -0,0 +1,126 @@
+// Package stripeotel provides an OpenTelemetry stripe.Tracer, creating a client span for every Stripe request
+//
+// The API key is never recorded, and IDs are replaced by {id} within span names so they can be grouped. Retried requests
+// have a span for every attempt, recording the attempt number and the resend count of the HTTP semantic conventions.
+package stripeotel
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/luxraise/stripe"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const instrumentationName = "github.com/luxraise/stripe/stripeotel"
+
+// Ensure Tracer satisfies the stripe tracer interface
+var _ stripe.Tracer = &Tracer{}
+
+// Option configures a Tracer during initialization
+type Option func(t *Tracer)
+
+// WithTracerProvider will set the provider used to create spans, defaults to the global TracerProvider
+func WithTracerProvider(provider trace.TracerProvider) Option {
+	return func(t *Tracer) {
+		t.provider = provider
+	}
+}
+
+// NewTracer initializes and returns a new Tracer, add it to a Client with stripe.WithTracer
+func NewTracer(opts ...Option) *Tracer {
+	var t Tracer
+	for _, opt := range opts {
+		opt(&t)
+	}
+
+	if t.provider == nil {
+		t.provider = otel.GetTracerProvider()
+	}
+
+	t.tracer = t.provider.Tracer(instrumentationName)
+	return &t
+}
+
+// Tracer creates an OpenTelemetry client span for every request performed by a stripe.Client
+type Tracer struct {
+	provider trace.TracerProvider
+	tracer   trace.Tracer
+}
+
+// StartSpan will start a client span as a child of the provided context
+func (t *Tracer) StartSpan(ctx context.Context, info stripe.RequestInfo) (context.Context, stripe.Span) {
+	attrs := []attribute.KeyValue{
+		attribute.String("http.request.method", info.Method),
+		attribute.String("url.template", info.Route),
+		attribute.Int("stripe.attempt", info.Attempt),
+	}
+
+	if info.Attempt > 1 {
+		attrs = append(attrs, attribute.Int("http.request.resend_count", info.Attempt-1))
+	}
+
+	if len(info.AccountID) > 0 {
+		attrs = append(attrs, attribute.String("stripe.account", info.AccountID))
+	}
+
+	ctx, span := t.tracer.Start(ctx, "stripe "+info.Method+" "+info.Route,
+		trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(attrs...))
+	return ctx, &Span{span: span}
+}
+
+// Span is the span of a single Stripe request
+type Span struct {
+	span trace.Span
+}
+
+// End will record the outcome of the request and end the span
+func (s *Span) End(info stripe.ResponseInfo, err error) {
+	if info.StatusCode != 0 {
+		s.span.SetAttributes(attribute.Int("http.response.status_code", info.StatusCode))
+	}
+
+	if len(info.RequestID) > 0 {
+		s.span.SetAttributes(attribute.String("stripe.request_id", info.RequestID))
+	}
+
+	if err != nil {
+		s.setError(info, err)
+	}
+
+	s.span.End()
+}
+
+func (s *Span) setError(info stripe.ResponseInfo, err error) {
+	var stripeErr *stripe.Error
+	if !errors.As(err, &stripeErr) {
+		errorType := "transport_error"
+		if info.StatusCode != 0 {
+			errorType = strconv.Itoa(info.StatusCode)
+		}
+
+		s.span.SetAttributes(attribute.String("error.type", errorType))
+		s.span.SetStatus(codes.Error, err.Error())
+		return
+	}
+
+	s.span.SetAttributes(
+		attribute.String("error.type", stripeErr.Type),
+		attribute.String("stripe.error.type", stripeErr.Type),
+	)
+
+	if len(stripeErr.Code) > 0 {
+		s.span.SetAttributes(attribute.String("stripe.error.code", stripeErr.Code))
+	}
+
+	if len(stripeErr.DeclineCode) > 0 {
+		s.span.SetAttributes(attribute.String("stripe.error.decline_code", stripeErr.DeclineCode))
+	}
+
+	// Error messages may echo parameters of the request, so only the type and code are recorded
+	s.span.SetStatus(codes.Error, stripeErr.Type)
+}
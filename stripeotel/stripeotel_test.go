@@ -0,0 +1,167 @@
+package stripeotel
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/luxraise/stripe"
+	"github.com/luxraise/stripe/stripetest"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestTracer(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+	s := stripetest.NewServer()
+	defer s.Close()
+
+	c, err := stripe.New(stripetest.APIKey, stripe.WithHost(s.URL), stripe.WithTracer(NewTracer(WithTracerProvider(provider))))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, parent := provider.Tracer("test").Start(context.Background(), "checkout")
+	client := c.WithContext(ctx)
+
+	var customer stripe.Customer
+	if customer, err = client.CreateCustomer(customer); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = client.ForAccount("acct_1032D82eZvKYlo2C").GetCustomer("cus_NffrFeUfNV2Hib"); err == nil {
+		t.Fatal("expected error for missing customer and received nil")
+	}
+
+	parent.End()
+
+	spans := recorder.Ended()
+	if len(spans) != 3 {
+		t.Fatalf("invalid number of spans, expected %d and received %d", 3, len(spans))
+	}
+
+	created, missing := spans[0], spans[1]
+	switch {
+	case created.Name() != "stripe POST /customers":
+		t.Fatalf("invalid span name, expected <%s> and received <%s>", "stripe POST /customers", created.Name())
+	case missing.Name() != "stripe GET /customers/{id}":
+		t.Fatalf("invalid span name, expected <%s> and received <%s>", "stripe GET /customers/{id}", missing.Name())
+	case created.SpanKind() != trace.SpanKindClient:
+		t.Fatalf("invalid span kind, expected <%v> and received <%v>", trace.SpanKindClient, created.SpanKind())
+	case created.Parent().SpanID() != parent.SpanContext().SpanID() || missing.Parent().SpanID() != parent.SpanContext().SpanID():
+		t.Fatal("invalid span parent, expected the span of the caller's context")
+	case created.Status().Code != codes.Unset:
+		t.Fatalf("invalid span status, expected <%v> and received <%v>", codes.Unset, created.Status().Code)
+	case missing.Status().Code != codes.Error:
+		t.Fatalf("invalid span status, expected <%v> and received <%v>", codes.Error, missing.Status().Code)
+	}
+
+	tcs := []struct {
+		span     sdktrace.ReadOnlySpan
+		key      attribute.Key
+		expected attribute.Value
+	}{
+		{span: created, key: "http.request.method", expected: attribute.StringValue("POST")},
+		{span: created, key: "url.template", expected: attribute.StringValue("/customers")},
+		{span: created, key: "http.response.status_code", expected: attribute.IntValue(200)},
+		{span: created, key: "stripe.attempt", expected: attribute.IntValue(1)},
+		{span: missing, key: "url.template", expected: attribute.StringValue("/customers/{id}")},
+		{span: missing, key: "http.response.status_code", expected: attribute.IntValue(404)},
+		{span: missing, key: "stripe.account", expected: attribute.StringValue("acct_1032D82eZvKYlo2C")},
+		{span: missing, key: "stripe.error.type", expected: attribute.StringValue("invalid_request_error")},
+		{span: missing, key: "stripe.error.code", expected: attribute.StringValue("resource_missing")},
+	}
+
+	for _, tc := range tcs {
+		value, ok := getAttribute(tc.span, tc.key)
+		if !ok || value != tc.expected {
+			t.Fatalf("invalid <%s> attribute of <%s>, expected <%s> and received <%s>", tc.key, tc.span.Name(), tc.expected.Emit(), value.Emit())
+		}
+	}
+
+	if value, ok := getAttribute(created, "stripe.request_id"); !ok || len(value.AsString()) == 0 {
+		t.Fatal("invalid request ID attribute, expected a value and received none")
+	}
+
+	for _, kv := range append(created.Attributes(), missing.Attributes()...) {
+		if kv.Value.Emit() == stripetest.APIKey {
+			t.Fatalf("invalid attribute <%s>, expected the API key to never be recorded", kv.Key)
+		}
+	}
+}
+
+func TestTracer_retries(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+	var (
+		mux   sync.Mutex
+		count int
+	)
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mux.Lock()
+		defer mux.Unlock()
+		if count++; count == 1 {
+			w.WriteHeader(503)
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"id":"cus_123","object":"customer"}`))
+	}))
+	defer s.Close()
+
+	c, err := stripe.New(stripetest.APIKey, stripe.WithHost(s.URL), stripe.WithMaxRetries(1), stripe.WithTracer(NewTracer(WithTracerProvider(provider))))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = c.GetCustomer("cus_123"); err != nil {
+		t.Fatal(err)
+	}
+
+	spans := recorder.Ended()
+	if len(spans) != 2 {
+		t.Fatalf("invalid number of spans, expected a span for each of the %d attempts and received %d", 2, len(spans))
+	}
+
+	tcs := []struct {
+		span     sdktrace.ReadOnlySpan
+		key      attribute.Key
+		expected attribute.Value
+	}{
+		{span: spans[0], key: "stripe.attempt", expected: attribute.IntValue(1)},
+		{span: spans[0], key: "http.response.status_code", expected: attribute.IntValue(503)},
+		{span: spans[1], key: "stripe.attempt", expected: attribute.IntValue(2)},
+		{span: spans[1], key: "http.request.resend_count", expected: attribute.IntValue(1)},
+		{span: spans[1], key: "http.response.status_code", expected: attribute.IntValue(200)},
+	}
+
+	for _, tc := range tcs {
+		value, ok := getAttribute(tc.span, tc.key)
+		if !ok || value != tc.expected {
+			t.Fatalf("invalid <%s> attribute of <%s>, expected <%s> and received <%s>", tc.key, tc.span.Name(), tc.expected.Emit(), value.Emit())
+		}
+	}
+
+	if _, ok := getAttribute(spans[0], "http.request.resend_count"); ok {
+		t.Fatal("invalid resend count attribute, expected none for the first attempt")
+	}
+}
+
+func getAttribute(span sdktrace.ReadOnlySpan, key attribute.Key) (value attribute.Value, ok bool) {
+	for _, kv := range span.Attributes() {
+		if kv.Key == key {
+			return kv.Value, true
+		}
+	}
+
+	return
+}
@@ -0,0 +1,329 @@
+package stripetest
+
+import (
+	"crypto/sha256"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Decline describes how a test card number fails
+type Decline struct {
+	Code        string
+	DeclineCode string
+	Message     string
+	// Whether the card can be attached to a customer, and only fails once it is charged
+	OnCharge bool
+}
+
+// declines are keyed by the test card numbers documented by Stripe
+var declines = map[string]Decline{
+	"4000000000000002": {Code: "card_declined", DeclineCode: "generic_decline", Message: "Your card was declined."},
+	"4000000000009995": {Code: "card_declined", DeclineCode: "insufficient_funds", Message: "Your card has insufficient funds."},
+	"4000000000009987": {Code: "card_declined", DeclineCode: "lost_card", Message: "Your card was declined."},
+	"4000000000009979": {Code: "card_declined", DeclineCode: "stolen_card", Message: "Your card was declined."},
+	"4100000000000019": {Code: "card_declined", DeclineCode: "fraudulent", Message: "Your card was declined."},
+	"4000000000000069": {Code: "expired_card", Message: "Your card has expired."},
+	"4000000000000127": {Code: "incorrect_cvc", Message: "Your card's security code is incorrect."},
+	"4000000000000119": {Code: "processing_error", Message: "An error occurred while processing your card. Try again in a little bit."},
+	"4000000000000341": {Code: "card_declined", DeclineCode: "generic_decline", Message: "Your card was declined.", OnCharge: true},
+}
+
+// LookupDecline returns how a test card number fails, ok is false for cards which succeed
+// The same decline table is used by the fake server and can be shared by other fakes
+func LookupDecline(number string) (d Decline, ok bool) {
+	d, ok = declines[number]
+	return
+}
+
+type card struct {
+	ID     string `json:"id"`
+	Object string `json:"object"`
+
+	Brand       string  `json:"brand"`
+	Country     string  `json:"country"`
+	Funding     string  `json:"funding"`
+	Fingerprint string  `json:"fingerprint"`
+	Last4       string  `json:"last4"`
+	ExpMonth    int64   `json:"exp_month"`
+	ExpYear     int64   `json:"exp_year"`
+	CVCCheck    *string `json:"cvc_check"`
+
+	Name           *string `json:"name"`
+	AddressLine1   *string `json:"address_line1"`
+	AddressLine2   *string `json:"address_line2"`
+	AddressCity    *string `json:"address_city"`
+	AddressState   *string `json:"address_state"`
+	AddressZip     *string `json:"address_zip"`
+	AddressCountry *string `json:"address_country"`
+
+	Customer *string           `json:"customer"`
+	Metadata map[string]string `json:"metadata"`
+
+	number string
+}
+
+type token struct {
+	ID     string `json:"id"`
+	Object string `json:"object"`
+	Type   string `json:"type"`
+
+	Card *card `json:"card"`
+
+	ClientIP *string `json:"client_ip"`
+	Livemode bool    `json:"livemode"`
+	Used     bool    `json:"used"`
+	Created  int64   `json:"created"`
+}
+
+func (s *Server) createToken(form url.Values) (value interface{}, err *apiError) {
+	var c *card
+	if c, err = newCard(form); err != nil {
+		return
+	}
+
+	var t token
+	t.ID = newID("tok")
+	t.Object = "token"
+	t.Type = "card"
+	t.Card = c
+	t.Created = time.Now().Unix()
+	s.tokens.put(t.ID, &t)
+	return &t, nil
+}
+
+func (s *Server) getToken(id string) (value interface{}, err *apiError) {
+	var ok bool
+	if value, ok = s.tokens.get(id); !ok {
+		return nil, newResourceMissingError("token", "token", id)
+	}
+
+	return
+}
+
+func (s *Server) createSource(customerID string, form url.Values) (value interface{}, err *apiError) {
+	var cus *customer
+	if cus, err = s.lookupCustomer(customerID, "customer", false); err != nil {
+		return
+	}
+
+	tokenID := form.Get("source")
+	if len(tokenID) == 0 {
+		return nil, newMissingParamError("source")
+	}
+
+	var t *token
+	if t, err = s.useToken(tokenID, "source"); err != nil {
+		return
+	}
+
+	c := *t.Card
+	if d, ok := declines[c.number]; ok && !d.OnCharge {
+		// Cards are verified with the issuer when they are attached to a customer
+		return nil, newCardError(d.Code, d.DeclineCode, "", d.Message)
+	}
+
+	c.Customer = &cus.ID
+	c.CVCCheck = stringPtr("pass")
+	c.Metadata = updateMetadata(nil, form)
+	s.cards.put(c.ID, &c)
+
+	if cus.DefaultSource == nil {
+		cus.DefaultSource = &c.ID
+	}
+
+	return &c, nil
+}
+
+func (s *Server) getSource(customerID, cardID string) (value interface{}, err *apiError) {
+	if _, err = s.lookupCustomer(customerID, "customer", false); err != nil {
+		return
+	}
+
+	return s.lookupCard(customerID, cardID, "id")
+}
+
+func (s *Server) deleteSource(customerID, cardID string) (value interface{}, err *apiError) {
+	var cus *customer
+	if cus, err = s.lookupCustomer(customerID, "customer", false); err != nil {
+		return
+	}
+
+	if _, err = s.lookupCard(customerID, cardID, "id"); err != nil {
+		return
+	}
+
+	s.cards.remove(cardID)
+	if cus.DefaultSource != nil && *cus.DefaultSource == cardID {
+		// The most recently added card becomes the new default source
+		cus.DefaultSource = nil
+		if ids := s.customerCardIDs(cus.ID); len(ids) > 0 {
+			cus.DefaultSource = &ids[len(ids)-1]
+		}
+	}
+
+	return newDeleted(cardID, "card"), nil
+}
+
+func (s *Server) listSources(customerID string, form url.Values) (value interface{}, err *apiError) {
+	if _, err = s.lookupCustomer(customerID, "customer", false); err != nil {
+		return
+	}
+
+	if object := form.Get("object"); len(object) > 0 && object != "card" {
+		// Only cards are supported as sources, no other objects will match
+		return newList("/v1/customers/" + customerID + "/sources"), nil
+	}
+
+	return s.cards.list(form, "source", "/v1/customers/"+customerID+"/sources", func(value interface{}) bool {
+		return *value.(*card).Customer == customerID
+	})
+}
+
+// lookupCard will return a card which belongs to the provided customer
+func (s *Server) lookupCard(customerID, cardID, param string) (c *card, err *apiError) {
+	value, ok := s.cards.get(cardID)
+	if !ok || *value.(*card).Customer != customerID {
+		return nil, newResourceMissingError("source", param, cardID)
+	}
+
+	return value.(*card), nil
+}
+
+// useToken will mark a token as used, tokens can only be used once
+func (s *Server) useToken(id, param string) (t *token, err *apiError) {
+	value, ok := s.tokens.get(id)
+	if !ok {
+		return nil, newResourceMissingError("token", param, id)
+	}
+
+	if t = value.(*token); t.Used {
+		return nil, newCodedError("token_already_used", param, "You cannot use a Stripe token more than once: "+id+".")
+	}
+
+	t.Used = true
+	return
+}
+
+// customerCardIDs returns the IDs of the cards of a customer, in the order they were added
+func (s *Server) customerCardIDs(customerID string) (ids []string) {
+	for _, id := range s.cards.ids {
+		if *s.cards.objects[id].(*card).Customer == customerID {
+			ids = append(ids, id)
+		}
+	}
+
+	return
+}
+
+func newCard(form url.Values) (c *card, err *apiError) {
+	number := strings.Replace(form.Get("card[number]"), " ", "", -1)
+	if len(number) == 0 {
+		return nil, newMissingParamError("card[number]")
+	}
+
+	if !isLuhnValid(number) {
+		return nil, newCardError("incorrect_number", "", "number", "Your card number is incorrect.")
+	}
+
+	var (
+		month, year int64
+		ok          bool
+	)
+
+	if month, ok, err = formInt64(form, "card[exp_month]"); err != nil {
+		return
+	} else if !ok {
+		return nil, newMissingParamError("card[exp_month]")
+	} else if month < 1 || month > 12 {
+		return nil, newCardError("invalid_expiry_month", "", "exp_month", "Your card's expiration month is invalid.")
+	}
+
+	if year, ok, err = formInt64(form, "card[exp_year]"); err != nil {
+		return
+	} else if !ok {
+		return nil, newMissingParamError("card[exp_year]")
+	} else if year < 100 {
+		year += 2000
+	}
+
+	now := time.Now()
+	if year < int64(now.Year()) || (year == int64(now.Year()) && month < int64(now.Month())) {
+		return nil, newCardError("invalid_expiry_year", "", "exp_year", "Your card's expiration year is invalid.")
+	}
+
+	c = &card{}
+	c.ID = newID("card")
+	c.Object = "card"
+	c.Brand = getBrand(number)
+	c.Country = "US"
+	c.Funding = "credit"
+	c.Fingerprint = newFingerprint(number)
+	c.Last4 = number[len(number)-4:]
+	c.ExpMonth = month
+	c.ExpYear = year
+	c.Name = formString(form, "card[name]")
+	c.AddressLine1 = formString(form, "card[address_line1]")
+	c.AddressLine2 = formString(form, "card[address_line2]")
+	c.AddressCity = formString(form, "card[address_city]")
+	c.AddressState = formString(form, "card[address_state]")
+	c.AddressZip = formString(form, "card[address_zip]")
+	c.AddressCountry = formString(form, "card[address_country]")
+	c.Metadata = map[string]string{}
+	c.number = number
+	return
+}
+
+func getBrand(number string) string {
+	switch {
+	case strings.HasPrefix(number, "4"):
+		return "Visa"
+	case strings.HasPrefix(number, "34"), strings.HasPrefix(number, "37"):
+		return "American Express"
+	case strings.HasPrefix(number, "6011"), strings.HasPrefix(number, "65"):
+		return "Discover"
+	case strings.HasPrefix(number, "35"):
+		return "JCB"
+	case strings.HasPrefix(number, "30"), strings.HasPrefix(number, "36"), strings.HasPrefix(number, "38"):
+		return "Diners Club"
+	case number[0] == '2' || number[0] == '5':
+		return "MasterCard"
+	default:
+		return "Unknown"
+	}
+}
+
+// newFingerprint returns a stable fingerprint for a card number, the same number always has the same fingerprint
+func newFingerprint(number string) string {
+	sum := sha256.Sum256([]byte(number))
+	bs := make([]byte, 16)
+	for i := range bs {
+		bs[i] = idAlphabet[int(sum[i])%len(idAlphabet)]
+	}
+
+	return string(bs)
+}
+
+func isLuhnValid(number string) bool {
+	var sum int
+	for i := len(number) - 1; i >= 0; i-- {
+		digit := int(number[i] - '0')
+		if digit < 0 || digit > 9 {
+			return false
+		}
+
+		if (len(number)-i)%2 == 0 {
+			if digit *= 2; digit > 9 {
+				digit -= 9
+			}
+		}
+
+		sum += digit
+	}
+
+	return len(number) >= 12 && sum%10 == 0
+}
+
+func stringPtr(str string) *string {
+	return &str
+}
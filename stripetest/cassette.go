@@ -0,0 +1,303 @@
+package stripetest
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+const (
+	// ModeRecord performs requests with the underlying transport and records every interaction
+	ModeRecord Mode = iota
+	// ModeReplayStrict replays interactions in their recorded order, requests must match the method, URL and body exactly
+	ModeReplayStrict
+	// ModeReplayLenient replays the first unused interaction with a matching method and path, query strings and bodies are ignored
+	ModeReplayLenient
+)
+
+// ErrNoInteraction is returned when a replayed request does not match a recorded interaction
+var ErrNoInteraction = errors.New("cassette: no recorded interaction matches the request")
+
+const redacted = "[REDACTED]"
+
+// cardNumberPattern matches anything which looks like a card number (PAN), candidates must also pass the Luhn check
+var cardNumberPattern = regexp.MustCompile(`\b\d{13,19}\b`)
+
+// Mode determines whether a Cassette records or replays interactions
+type Mode int
+
+// NewCassette initializes and returns a new Cassette for the fixture file at the provided path
+// When replaying, the fixture file is loaded and must exist
+func NewCassette(path string, mode Mode) (c *Cassette, err error) {
+	var cc Cassette
+	cc.path = path
+	cc.mode = mode
+	cc.Transport = http.DefaultTransport
+	if mode != ModeRecord {
+		if err = cc.load(); err != nil {
+			return
+		}
+	}
+
+	c = &cc
+	return
+}
+
+// Cassette is a http.RoundTripper which records request/response pairs to a JSON fixture file and replays them
+// Authorization headers, card numbers and CVCs are scrubbed before anything is recorded
+type Cassette struct {
+	mux sync.Mutex
+
+	path string
+	mode Mode
+
+	interactions []Interaction
+	used         []bool
+	next         int
+
+	// Transport performs the requests while recording, defaults to http.DefaultTransport
+	Transport http.RoundTripper
+}
+
+// Interaction is a single recorded request/response pair
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// RecordedRequest is a scrubbed HTTP request
+type RecordedRequest struct {
+	Method string `json:"method"`
+	// The path and query of the request, the host is not recorded
+	URL     string      `json:"url"`
+	Headers http.Header `json:"headers"`
+	Body    string      `json:"body"`
+}
+
+// RecordedResponse is a scrubbed HTTP response
+type RecordedResponse struct {
+	StatusCode int         `json:"status_code"`
+	Headers    http.Header `json:"headers"`
+	Body       string      `json:"body"`
+}
+
+// RoundTrip will record or replay the request, depending on the Mode of the Cassette
+func (c *Cassette) RoundTrip(req *http.Request) (resp *http.Response, err error) {
+	var recorded RecordedRequest
+	if recorded, err = newRecordedRequest(req); err != nil {
+		return
+	}
+
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	if c.mode == ModeRecord {
+		return c.record(req, recorded)
+	}
+
+	var index int
+	if index, err = c.match(recorded); err != nil {
+		return
+	}
+
+	c.used[index] = true
+	return c.interactions[index].Response.toResponse(req), nil
+}
+
+// Save will write every recorded interaction to the fixture file, creating its directory when needed
+func (c *Cassette) Save() (err error) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	var bs []byte
+	if bs, err = json.MarshalIndent(c.interactions, "", "\t"); err != nil {
+		return fmt.Errorf("error encoding cassette: %v", err)
+	}
+
+	if err = os.MkdirAll(filepath.Dir(c.path), 0755); err != nil {
+		return fmt.Errorf("error creating cassette directory: %v", err)
+	}
+
+	return ioutil.WriteFile(c.path, bs, 0644)
+}
+
+// Interactions returns a copy of the recorded interactions
+func (c *Cassette) Interactions() []Interaction {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	return append([]Interaction(nil), c.interactions...)
+}
+
+func (c *Cassette) record(req *http.Request, recorded RecordedRequest) (resp *http.Response, err error) {
+	if resp, err = c.Transport.RoundTrip(req); err != nil {
+		return
+	}
+	defer resp.Body.Close()
+
+	var bs []byte
+	if bs, err = ioutil.ReadAll(resp.Body); err != nil {
+		return nil, fmt.Errorf("error reading response body: %v", err)
+	}
+
+	var interaction Interaction
+	interaction.Request = recorded
+	interaction.Response.StatusCode = resp.StatusCode
+	interaction.Response.Headers = resp.Header.Clone()
+	interaction.Response.Body = scrubCardNumbers(string(bs))
+	c.interactions = append(c.interactions, interaction)
+	c.used = append(c.used, true)
+
+	// The caller receives the original, unscrubbed, response body
+	resp.Body = ioutil.NopCloser(bytes.NewReader(bs))
+	return
+}
+
+func (c *Cassette) match(recorded RecordedRequest) (index int, err error) {
+	if c.mode == ModeReplayStrict {
+		if c.next >= len(c.interactions) {
+			return -1, fmt.Errorf("%w: %s %s, all %d interactions have been replayed", ErrNoInteraction, recorded.Method, recorded.URL, len(c.interactions))
+		}
+
+		expected := c.interactions[c.next].Request
+		if expected.Method != recorded.Method || expected.URL != recorded.URL || expected.Body != recorded.Body {
+			return -1, fmt.Errorf("%w: expected %s %s <%s> and received %s %s <%s>", ErrNoInteraction,
+				expected.Method, expected.URL, expected.Body, recorded.Method, recorded.URL, recorded.Body)
+		}
+
+		c.next++
+		return c.next - 1, nil
+	}
+
+	path := stripQuery(recorded.URL)
+	for i, interaction := range c.interactions {
+		if c.used[i] || interaction.Request.Method != recorded.Method || stripQuery(interaction.Request.URL) != path {
+			continue
+		}
+
+		return i, nil
+	}
+
+	return -1, fmt.Errorf("%w: %s %s", ErrNoInteraction, recorded.Method, recorded.URL)
+}
+
+func (c *Cassette) load() (err error) {
+	var bs []byte
+	if bs, err = ioutil.ReadFile(c.path); err != nil {
+		return fmt.Errorf("error reading cassette: %v", err)
+	}
+
+	if err = json.Unmarshal(bs, &c.interactions); err != nil {
+		return fmt.Errorf("error decoding cassette: %v", err)
+	}
+
+	c.used = make([]bool, len(c.interactions))
+	return
+}
+
+func (r *RecordedResponse) toResponse(req *http.Request) *http.Response {
+	var resp http.Response
+	resp.StatusCode = r.StatusCode
+	resp.Status = fmt.Sprintf("%d %s", r.StatusCode, http.StatusText(r.StatusCode))
+	resp.Proto = "HTTP/1.1"
+	resp.ProtoMajor = 1
+	resp.ProtoMinor = 1
+	resp.Header = r.Headers.Clone()
+	if resp.Header == nil {
+		resp.Header = make(http.Header)
+	}
+
+	resp.Body = ioutil.NopCloser(strings.NewReader(r.Body))
+	resp.ContentLength = int64(len(r.Body))
+	resp.Request = req
+	return &resp
+}
+
+func newRecordedRequest(req *http.Request) (recorded RecordedRequest, err error) {
+	recorded.Method = req.Method
+	recorded.URL = scrubCardNumbers(req.URL.RequestURI())
+	recorded.Headers = req.Header.Clone()
+	if len(recorded.Headers.Get("Authorization")) > 0 {
+		recorded.Headers.Set("Authorization", redacted)
+	}
+
+	if req.Body == nil {
+		return
+	}
+
+	var bs []byte
+	if bs, err = ioutil.ReadAll(req.Body); err != nil {
+		err = fmt.Errorf("error reading request body: %v", err)
+		return
+	}
+
+	req.Body.Close()
+	// The body is restored so it can still be sent by the underlying transport
+	req.Body = ioutil.NopCloser(bytes.NewReader(bs))
+
+	recorded.Body = string(bs)
+	if strings.HasPrefix(req.Header.Get("Content-Type"), "application/x-www-form-urlencoded") {
+		recorded.Body = scrubForm(recorded.Body)
+	}
+
+	recorded.Body = scrubCardNumbers(recorded.Body)
+	return
+}
+
+// scrubForm will redact the card number and CVC parameters of a form encoded body
+func scrubForm(body string) string {
+	form, err := url.ParseQuery(body)
+	if err != nil {
+		return body
+	}
+
+	for key, values := range form {
+		if !isSensitiveKey(key) {
+			continue
+		}
+
+		for i := range values {
+			values[i] = redacted
+		}
+	}
+
+	return form.Encode()
+}
+
+func isSensitiveKey(key string) bool {
+	for _, field := range []string{"number", "cvc"} {
+		if key == field || strings.HasSuffix(key, "["+field+"]") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// scrubCardNumbers will mask any Luhn valid number which looks like a card number, keeping the last four digits
+// Other long numbers (such as timestamps in nanoseconds or tracking numbers) are left untouched
+func scrubCardNumbers(str string) string {
+	return cardNumberPattern.ReplaceAllStringFunc(str, func(number string) string {
+		if !isLuhnValid(number) {
+			return number
+		}
+
+		return strings.Repeat("X", len(number)-4) + number[len(number)-4:]
+	})
+}
+
+func stripQuery(requestURI string) string {
+	if i := strings.IndexByte(requestURI, '?'); i != -1 {
+		return requestURI[:i]
+	}
+
+	return requestURI
+}
@@ -0,0 +1,119 @@
+package stripetest
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestCassette(t *testing.T) {
+	s := NewServer()
+	path := filepath.Join(t.TempDir(), "cassette.json")
+
+	recorder, err := NewCassette(path, ModeRecord)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	card := url.Values{"card[number]": {"4242424242424242"}, "card[cvc]": {"123"}, "card[exp_month]": {"12"}, "card[exp_year]": {"2099"}}
+	recorded := send(t, recorder, s.URL, "POST", "/v1/tokens", card)
+	s.Close()
+
+	if err = recorder.Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	bs, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, secret := range []string{"4242424242424242", APIKey, "cvc%5D=123"} {
+		if strings.Contains(string(bs), secret) {
+			t.Fatalf("invalid cassette, expected <%s> to be scrubbed", secret)
+		}
+	}
+
+	strict, err := NewCassette(path, ModeReplayStrict)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The server has been closed, responses can only come from the cassette
+	if replayed := send(t, strict, s.URL, "POST", "/v1/tokens", card); replayed != recorded {
+		t.Fatalf("invalid response, expected <%s> and received <%s>", recorded, replayed)
+	}
+
+	if _, err = roundTrip(strict, s.URL, "POST", "/v1/tokens", card); !errors.Is(err, ErrNoInteraction) {
+		t.Fatalf("invalid error, expected %v and received %v", ErrNoInteraction, err)
+	}
+
+	strict, err = NewCassette(path, ModeReplayStrict)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	card.Set("card[exp_month]", "11")
+	if _, err = roundTrip(strict, s.URL, "POST", "/v1/tokens", card); !errors.Is(err, ErrNoInteraction) {
+		t.Fatalf("invalid error, expected %v and received %v", ErrNoInteraction, err)
+	}
+
+	lenient, err := NewCassette(path, ModeReplayLenient)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if replayed := send(t, lenient, s.URL, "POST", "/v1/tokens", card); replayed != recorded {
+		t.Fatalf("invalid response, expected <%s> and received <%s>", recorded, replayed)
+	}
+}
+
+func Test_scrubCardNumbers(t *testing.T) {
+	tcs := []struct {
+		value    string
+		expected string
+	}{
+		{value: `{"number":"4242424242424242"}`, expected: `{"number":"XXXXXXXXXXXX4242"}`},
+		{value: `{"number":"4000056655665556"}`, expected: `{"number":"XXXXXXXXXXXX5556"}`},
+		{value: `{"tracking_number":"1234567890123"}`, expected: `{"tracking_number":"1234567890123"}`},
+		{value: `{"created_ns":1700000000000000000}`, expected: `{"created_ns":1700000000000000000}`},
+		{value: `{"amount":1000}`, expected: `{"amount":1000}`},
+	}
+
+	for _, tc := range tcs {
+		if scrubbed := scrubCardNumbers(tc.value); scrubbed != tc.expected {
+			t.Fatalf("invalid scrubbed value, expected <%s> and received <%s>", tc.expected, scrubbed)
+		}
+	}
+}
+
+func send(t *testing.T, c *Cassette, host, method, path string, form url.Values) (body string) {
+	resp, err := roundTrip(c, host, method, path, form)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	bs, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return string(bs)
+}
+
+func roundTrip(c *Cassette, host, method, path string, form url.Values) (resp *http.Response, err error) {
+	var req *http.Request
+	if req, err = http.NewRequest(method, host+path, strings.NewReader(form.Encode())); err != nil {
+		return
+	}
+
+	req.Header.Set("Authorization", "Bearer "+APIKey)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	hc := http.Client{Transport: c}
+	return hc.Do(req)
+}
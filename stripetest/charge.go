@@ -0,0 +1,166 @@
+package stripetest
+
+import (
+	"net/url"
+	"strings"
+	"time"
+)
+
+// minimumAmount is the smallest amount which can be charged, in cents
+const minimumAmount = 50
+
+type charge struct {
+	ID     string `json:"id"`
+	Object string `json:"object"`
+
+	Amount             int64   `json:"amount"`
+	AmountRefunded     int64   `json:"amount_refunded"`
+	Currency           string  `json:"currency"`
+	Customer           *string `json:"customer"`
+	Description        *string `json:"description"`
+	Source             *card   `json:"source"`
+	PaymentMethod      string  `json:"payment_method"`
+	BalanceTransaction *string `json:"balance_transaction"`
+
+	Captured       bool    `json:"captured"`
+	Disputed       bool    `json:"disputed"`
+	Paid           bool    `json:"paid"`
+	Refunded       bool    `json:"refunded"`
+	Status         string  `json:"status"`
+	FailureCode    *string `json:"failure_code"`
+	FailureMessage *string `json:"failure_message"`
+
+	Metadata map[string]string `json:"metadata"`
+	Livemode bool              `json:"livemode"`
+	Created  int64             `json:"created"`
+}
+
+func (s *Server) createCharge(form url.Values) (value interface{}, err *apiError) {
+	var (
+		amount int64
+		ok     bool
+	)
+
+	if amount, ok, err = formPositiveInt64(form, "amount"); err != nil {
+		return
+	} else if !ok {
+		return nil, newMissingParamError("amount")
+	}
+
+	currency := strings.ToLower(form.Get("currency"))
+	if len(currency) == 0 {
+		return nil, newMissingParamError("currency")
+	}
+
+	if amount < minimumAmount {
+		return nil, newCodedError("amount_too_small", "amount", "Amount must be at least $"+formatAmount(minimumAmount)+" "+currency)
+	}
+
+	var source *card
+	if source, err = s.getChargeSource(form); err != nil {
+		return
+	}
+
+	var c charge
+	c.ID = newID("ch")
+	c.Object = "charge"
+	c.Amount = amount
+	c.Currency = currency
+	c.Customer = source.Customer
+	c.Description = formString(form, "description")
+	c.Source = source
+	c.PaymentMethod = source.ID
+	c.Metadata = updateMetadata(nil, form)
+	c.Created = time.Now().Unix()
+
+	if d, ok := declines[source.number]; ok {
+		// Declined charges are still created, the error references the failed charge
+		c.Status = "failed"
+		c.FailureCode = &d.Code
+		c.FailureMessage = &d.Message
+		s.charges.put(c.ID, &c)
+
+		err = newCardError(d.Code, d.DeclineCode, "", d.Message)
+		err.Charge = c.ID
+		return
+	}
+
+	c.Status = "succeeded"
+	c.Captured = true
+	c.Paid = true
+	c.BalanceTransaction = stringPtr(newID("txn"))
+	s.charges.put(c.ID, &c)
+	return &c, nil
+}
+
+func (s *Server) getCharge(id string) (value interface{}, err *apiError) {
+	return s.lookupCharge(id, "id")
+}
+
+func (s *Server) updateCharge(id string, form url.Values) (value interface{}, err *apiError) {
+	var c *charge
+	if c, err = s.lookupCharge(id, "id"); err != nil {
+		return
+	}
+
+	updateString(&c.Description, form, "description")
+	c.Metadata = updateMetadata(c.Metadata, form)
+	return c, nil
+}
+
+func (s *Server) listCharges(form url.Values) (value interface{}, err *apiError) {
+	customerID := form.Get("customer")
+	return s.charges.list(form, "charge", "/v1/charges", func(value interface{}) bool {
+		c := value.(*charge)
+		return len(customerID) == 0 || (c.Customer != nil && *c.Customer == customerID)
+	})
+}
+
+// getChargeSource returns the card to charge, either a card of the customer or a card token
+func (s *Server) getChargeSource(form url.Values) (source *card, err *apiError) {
+	customerID := form.Get("customer")
+	sourceID := form.Get("source")
+	if len(customerID) == 0 {
+		if len(sourceID) == 0 {
+			return nil, newMissingParamError("source")
+		}
+
+		var t *token
+		if t, err = s.useToken(sourceID, "source"); err != nil {
+			return
+		}
+
+		c := *t.Card
+		return &c, nil
+	}
+
+	var cus *customer
+	if cus, err = s.lookupCustomer(customerID, "customer", false); err != nil {
+		return
+	}
+
+	if len(sourceID) == 0 {
+		if cus.DefaultSource == nil {
+			return nil, newCodedError("missing", "card", "Cannot charge a customer that has no active card")
+		}
+
+		sourceID = *cus.DefaultSource
+	}
+
+	if _, err = s.lookupCard(customerID, sourceID, "source"); err != nil {
+		return nil, newCodedError("missing", "source", "Customer "+customerID+" does not have a linked source with ID "+sourceID+".")
+	}
+
+	value, _ := s.cards.get(sourceID)
+	c := *value.(*card)
+	return &c, nil
+}
+
+func (s *Server) lookupCharge(id, param string) (c *charge, err *apiError) {
+	value, ok := s.charges.get(id)
+	if !ok {
+		return nil, newResourceMissingError("charge", param, id)
+	}
+
+	return value.(*charge), nil
+}
@@ -0,0 +1,147 @@
+package stripetest
+
+import (
+	"net/url"
+	"time"
+)
+
+type customer struct {
+	ID     string `json:"id"`
+	Object string `json:"object"`
+
+	Name          *string           `json:"name"`
+	Description   *string           `json:"description"`
+	Email         *string           `json:"email"`
+	Phone         *string           `json:"phone"`
+	DefaultSource *string           `json:"default_source"`
+	Address       map[string]string `json:"address"`
+	Balance       int64             `json:"balance"`
+	Currency      *string           `json:"currency"`
+
+	PreferredLocales []string          `json:"preferred_locales"`
+	Metadata         map[string]string `json:"metadata"`
+
+	Livemode   bool  `json:"livemode"`
+	Delinquent bool  `json:"delinquent"`
+	Created    int64 `json:"created"`
+
+	deleted bool
+}
+
+func (s *Server) createCustomer(form url.Values) (value interface{}, err *apiError) {
+	var c customer
+	c.ID = newID("cus")
+	c.Object = "customer"
+	c.PreferredLocales = []string{}
+	c.Created = time.Now().Unix()
+	if err = s.applyCustomer(&c, form); err != nil {
+		return
+	}
+
+	s.customers.put(c.ID, &c)
+	return &c, nil
+}
+
+func (s *Server) getCustomer(id string) (value interface{}, err *apiError) {
+	var c *customer
+	if c, err = s.lookupCustomer(id, "id", true); err != nil {
+		return
+	}
+
+	if c.deleted {
+		// Deleted customers can still be retrieved, only their ID remains
+		return newDeleted(c.ID, c.Object), nil
+	}
+
+	return c, nil
+}
+
+func (s *Server) updateCustomer(id string, form url.Values) (value interface{}, err *apiError) {
+	var c *customer
+	if c, err = s.lookupCustomer(id, "id", false); err != nil {
+		return
+	}
+
+	// Changes are applied to a copy, leaving the customer untouched when a parameter is invalid
+	updated := *c
+	if err = s.applyCustomer(&updated, form); err != nil {
+		return
+	}
+
+	*c = updated
+	return c, nil
+}
+
+func (s *Server) deleteCustomer(id string) (value interface{}, err *apiError) {
+	var c *customer
+	if c, err = s.lookupCustomer(id, "id", false); err != nil {
+		return
+	}
+
+	c.deleted = true
+	for _, cardID := range s.customerCardIDs(c.ID) {
+		s.cards.remove(cardID)
+	}
+
+	return newDeleted(c.ID, c.Object), nil
+}
+
+func (s *Server) listCustomers(form url.Values) (value interface{}, err *apiError) {
+	email := form.Get("email")
+	return s.customers.list(form, "customer", "/v1/customers", func(value interface{}) bool {
+		c := value.(*customer)
+		return !c.deleted && (len(email) == 0 || (c.Email != nil && *c.Email == email))
+	})
+}
+
+func (s *Server) applyCustomer(c *customer, form url.Values) (err *apiError) {
+	updateString(&c.Name, form, "name")
+	updateString(&c.Description, form, "description")
+	updateString(&c.Email, form, "email")
+	updateString(&c.Phone, form, "phone")
+	updateString(&c.Currency, form, "currency")
+	c.Metadata = updateMetadata(c.Metadata, form)
+
+	if locales, ok := formSlice(form, "preferred_locales"); ok {
+		c.PreferredLocales = append([]string{}, locales...)
+	}
+
+	if address, ok := formDictionary(form, "address"); ok {
+		c.Address = address
+	}
+
+	var (
+		balance int64
+		ok      bool
+	)
+
+	if balance, ok, err = formInt64(form, "balance"); err != nil {
+		return
+	} else if ok {
+		c.Balance = balance
+	}
+
+	if sourceID := formString(form, "default_source"); sourceID != nil {
+		if indexOf(s.customerCardIDs(c.ID), *sourceID) == -1 {
+			return newResourceMissingError("source", "default_source", *sourceID)
+		}
+
+		c.DefaultSource = sourceID
+	}
+
+	return
+}
+
+// lookupCustomer will return a customer by ID, deleted customers are only returned when allowDeleted is true
+func (s *Server) lookupCustomer(id, param string, allowDeleted bool) (c *customer, err *apiError) {
+	value, ok := s.customers.get(id)
+	if !ok {
+		return nil, newResourceMissingError("customer", param, id)
+	}
+
+	if c = value.(*customer); c.deleted && !allowDeleted {
+		return nil, newResourceMissingError("customer", param, id)
+	}
+
+	return
+}
@@ -0,0 +1,74 @@
+package stripetest
+
+import (
+	"fmt"
+	"net/http"
+)
+
+const (
+	errorTypeCard           = "card_error"
+	errorTypeInvalidRequest = "invalid_request_error"
+)
+
+type errorResponse struct {
+	Error *apiError `json:"error"`
+}
+
+// apiError mirrors the error object returned by the Stripe API
+type apiError struct {
+	status int
+
+	Type        string `json:"type"`
+	Code        string `json:"code,omitempty"`
+	DeclineCode string `json:"decline_code,omitempty"`
+	Message     string `json:"message"`
+	Param       string `json:"param,omitempty"`
+	DocURL      string `json:"doc_url,omitempty"`
+	// The ID of the failed charge, only set for charge declines
+	Charge string `json:"charge,omitempty"`
+}
+
+func newInvalidRequestError(param, message string) *apiError {
+	var e apiError
+	e.status = http.StatusBadRequest
+	e.Type = errorTypeInvalidRequest
+	e.Param = param
+	e.Message = message
+	return &e
+}
+
+func newCodedError(code, param, message string) *apiError {
+	e := newInvalidRequestError(param, message)
+	e.setCode(code)
+	return e
+}
+
+func newCardError(code, declineCode, param, message string) *apiError {
+	var e apiError
+	e.status = http.StatusPaymentRequired
+	e.Type = errorTypeCard
+	e.DeclineCode = declineCode
+	e.Param = param
+	e.Message = message
+	e.setCode(code)
+	return &e
+}
+
+func newMissingParamError(param string) *apiError {
+	return newCodedError("parameter_missing", param, fmt.Sprintf("Missing required param: %s.", param))
+}
+
+func newInvalidIntegerError(param, value string) *apiError {
+	return newCodedError("parameter_invalid_integer", param, fmt.Sprintf("Invalid integer: %s", value))
+}
+
+func newResourceMissingError(object, param, id string) *apiError {
+	e := newCodedError("resource_missing", param, fmt.Sprintf("No such %s: '%s'", object, id))
+	e.status = http.StatusNotFound
+	return e
+}
+
+func (e *apiError) setCode(code string) {
+	e.Code = code
+	e.DocURL = "https://stripe.com/docs/error-codes/" + code
+}
@@ -0,0 +1,126 @@
+package stripetest
+
+import (
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// formString returns a pointer to the form value, nil is returned when the value is not set or empty
+func formString(form url.Values, key string) *string {
+	value := form.Get(key)
+	if len(value) == 0 {
+		return nil
+	}
+
+	return &value
+}
+
+// formInt64 parses a form value as an integer, ok is false when the value is not set
+func formInt64(form url.Values, key string) (value int64, ok bool, err *apiError) {
+	str := form.Get(key)
+	if len(str) == 0 {
+		return
+	}
+
+	var parseErr error
+	if value, parseErr = strconv.ParseInt(str, 10, 64); parseErr != nil {
+		err = newInvalidIntegerError(key, str)
+		return
+	}
+
+	ok = true
+	return
+}
+
+// formPositiveInt64 parses a form value as a positive integer, ok is false when the value is not set
+func formPositiveInt64(form url.Values, key string) (value int64, ok bool, err *apiError) {
+	if value, ok, err = formInt64(form, key); err != nil || !ok {
+		return
+	}
+
+	if value <= 0 {
+		err = newCodedError("parameter_invalid_integer", key, "This value must be greater than or equal to 1.")
+	}
+
+	return
+}
+
+// formDictionary collects the values of a hash parameter, such as metadata[order_id]
+func formDictionary(form url.Values, key string) (dict map[string]string, ok bool) {
+	prefix := key + "["
+	for formKey := range form {
+		if !strings.HasPrefix(formKey, prefix) || !strings.HasSuffix(formKey, "]") {
+			continue
+		}
+
+		if dict == nil {
+			dict = make(map[string]string)
+		}
+
+		dict[formKey[len(prefix):len(formKey)-1]] = form.Get(formKey)
+	}
+
+	_, set := form[key]
+	ok = dict != nil || set
+	return
+}
+
+// formSlice collects the values of an array parameter, such as preferred_locales[0]
+func formSlice(form url.Values, key string) (values []string, ok bool) {
+	dict, ok := formDictionary(form, key)
+	indexes := make([]int, 0, len(dict))
+	byIndex := make(map[int]string, len(dict))
+	for k, value := range dict {
+		index, err := strconv.Atoi(k)
+		if err != nil {
+			continue
+		}
+
+		indexes = append(indexes, index)
+		byIndex[index] = value
+	}
+
+	sort.Ints(indexes)
+	for _, index := range indexes {
+		values = append(values, byIndex[index])
+	}
+
+	return
+}
+
+// updateMetadata applies metadata parameters, empty values remove their key and an empty metadata value clears all keys
+func updateMetadata(metadata map[string]string, form url.Values) map[string]string {
+	if metadata == nil {
+		metadata = make(map[string]string)
+	}
+
+	if _, ok := form["metadata"]; ok && len(form.Get("metadata")) == 0 {
+		return make(map[string]string)
+	}
+
+	dict, _ := formDictionary(form, "metadata")
+	for key, value := range dict {
+		if len(value) == 0 {
+			delete(metadata, key)
+			continue
+		}
+
+		metadata[key] = value
+	}
+
+	return metadata
+}
+
+// updateString applies a string parameter, an empty value unsets the field
+func updateString(field **string, form url.Values, key string) {
+	if _, ok := form[key]; ok {
+		*field = formString(form, key)
+	}
+}
+
+// formatAmount formats an amount in the smallest currency unit as dollars, e.g. 1337 becomes 13.37
+func formatAmount(amount int64) string {
+	return strconv.FormatFloat(float64(amount)/100, 'f', 2, 64)
+}
@@ -0,0 +1,99 @@
+package stripetest
+
+import (
+	"net/url"
+	"time"
+)
+
+type refund struct {
+	ID     string `json:"id"`
+	Object string `json:"object"`
+
+	Amount             int64   `json:"amount"`
+	Charge             string  `json:"charge"`
+	Currency           string  `json:"currency"`
+	PaymentIntent      *string `json:"payment_intent"`
+	Reason             *string `json:"reason"`
+	BalanceTransaction *string `json:"balance_transaction"`
+	Status             string  `json:"status"`
+
+	Metadata map[string]string `json:"metadata"`
+	Created  int64             `json:"created"`
+}
+
+func (s *Server) createRefund(form url.Values) (value interface{}, err *apiError) {
+	chargeID := form.Get("charge")
+	if len(chargeID) == 0 {
+		return nil, newInvalidRequestError("", "One of the following params should be provided for this request: payment_intent or charge.")
+	}
+
+	var c *charge
+	if c, err = s.lookupCharge(chargeID, "charge"); err != nil {
+		return
+	}
+
+	if !c.Paid {
+		return nil, newInvalidRequestError("charge", "Charge "+c.ID+" cannot be refunded because it has failed.")
+	}
+
+	remaining := c.Amount - c.AmountRefunded
+	if remaining == 0 {
+		return nil, newCodedError("charge_already_refunded", "charge", "Charge "+c.ID+" has already been refunded.")
+	}
+
+	var (
+		amount int64
+		ok     bool
+	)
+
+	if amount, ok, err = formPositiveInt64(form, "amount"); err != nil {
+		return
+	} else if !ok {
+		amount = remaining
+	} else if amount > remaining {
+		msg := "Refund amount ($" + formatAmount(amount) + ") is greater than unrefunded amount on charge ($" + formatAmount(remaining) + ")"
+		return nil, newInvalidRequestError("amount", msg)
+	}
+
+	reason := formString(form, "reason")
+	if reason != nil {
+		switch *reason {
+		case "duplicate", "fraudulent", "requested_by_customer":
+		default:
+			return nil, newInvalidRequestError("reason", "Invalid reason: must be one of duplicate, fraudulent, or requested_by_customer")
+		}
+	}
+
+	var r refund
+	r.ID = newID("re")
+	r.Object = "refund"
+	r.Amount = amount
+	r.Charge = c.ID
+	r.Currency = c.Currency
+	r.Reason = reason
+	r.BalanceTransaction = stringPtr(newID("txn"))
+	r.Status = "succeeded"
+	r.Metadata = updateMetadata(nil, form)
+	r.Created = time.Now().Unix()
+	s.refunds.put(r.ID, &r)
+
+	c.AmountRefunded += amount
+	c.Refunded = c.AmountRefunded == c.Amount
+	return &r, nil
+}
+
+func (s *Server) getRefund(id string) (value interface{}, err *apiError) {
+	var ok bool
+	if value, ok = s.refunds.get(id); !ok {
+		return nil, newResourceMissingError("refund", "id", id)
+	}
+
+	return
+}
+
+func (s *Server) listRefunds(form url.Values) (value interface{}, err *apiError) {
+	chargeID := form.Get("charge")
+	return s.refunds.list(form, "refund", "/v1/refunds", func(value interface{}) bool {
+		return len(chargeID) == 0 || value.(*refund).Charge == chargeID
+	})
+}
@@ -0,0 +1,161 @@
+// Package stripetest provides an in-process fake of the Stripe API for tests
+//
+// The fake implements the customer, token, source (card), charge and refund endpoints. Test card numbers
+// behave as they do within the Stripe test mode, for example 4242424242424242 succeeds while
+// 4000000000000002 is declined when it is attached to a customer.
+package stripetest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+)
+
+// APIKey is an API key accepted by the fake server, any key with the sk_test_ prefix is accepted
+const APIKey = "sk_test_stripetest"
+
+const apiPrefix = "/v1/"
+
+// NewServer initializes and starts a new fake Stripe Server
+// Note: The caller is responsible for closing the Server
+func NewServer() *Server {
+	var s Server
+	s.customers = newStore()
+	s.tokens = newStore()
+	s.cards = newStore()
+	s.charges = newStore()
+	s.refunds = newStore()
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return &s
+}
+
+// Server is an in-process fake of the Stripe API, the URL of the embedded httptest.Server is the API host
+type Server struct {
+	*httptest.Server
+
+	mux sync.Mutex
+
+	customers *store
+	tokens    *store
+	cards     *store
+	charges   *store
+	refunds   *store
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	if err := authorize(r); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	if err := r.ParseForm(); err != nil {
+		writeError(w, newInvalidRequestError("", "Invalid request body: "+err.Error()))
+		return
+	}
+
+	value, err := s.route(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, value)
+}
+
+func (s *Server) route(r *http.Request) (value interface{}, err *apiError) {
+	var parts []string
+	if strings.HasPrefix(r.URL.Path, apiPrefix) {
+		parts = strings.Split(strings.TrimPrefix(r.URL.Path, apiPrefix), "/")
+	}
+
+	key := r.Method + " " + strings.Join(routeKey(parts), "/")
+	switch key {
+	case "GET customers":
+		return s.listCustomers(r.Form)
+	case "POST customers":
+		return s.createCustomer(r.Form)
+	case "GET customers/:id":
+		return s.getCustomer(parts[1])
+	case "POST customers/:id":
+		return s.updateCustomer(parts[1], r.Form)
+	case "DELETE customers/:id":
+		return s.deleteCustomer(parts[1])
+	case "GET customers/:id/sources":
+		return s.listSources(parts[1], r.Form)
+	case "POST customers/:id/sources":
+		return s.createSource(parts[1], r.Form)
+	case "GET customers/:id/sources/:id":
+		return s.getSource(parts[1], parts[3])
+	case "DELETE customers/:id/sources/:id":
+		return s.deleteSource(parts[1], parts[3])
+	case "POST tokens":
+		return s.createToken(r.Form)
+	case "GET tokens/:id":
+		return s.getToken(parts[1])
+	case "GET charges":
+		return s.listCharges(r.Form)
+	case "POST charges":
+		return s.createCharge(r.Form)
+	case "GET charges/:id":
+		return s.getCharge(parts[1])
+	case "POST charges/:id":
+		return s.updateCharge(parts[1], r.Form)
+	case "GET refunds":
+		return s.listRefunds(r.Form)
+	case "POST refunds":
+		return s.createRefund(r.Form)
+	case "GET refunds/:id":
+		return s.getRefund(parts[1])
+	}
+
+	err = newInvalidRequestError("", "Unrecognized request URL ("+r.Method+": "+r.URL.Path+").")
+	err.status = http.StatusNotFound
+	return
+}
+
+// routeKey replaces the ID segments of a path with a placeholder, e.g. customers/cus_123 becomes customers/:id
+func routeKey(parts []string) (key []string) {
+	key = make([]string, len(parts))
+	for i, part := range parts {
+		if i%2 == 1 && len(part) > 0 {
+			part = ":id"
+		}
+
+		key[i] = part
+	}
+
+	return
+}
+
+func authorize(r *http.Request) (err *apiError) {
+	apiKey := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	switch {
+	case len(apiKey) == 0:
+		err = newInvalidRequestError("", "You did not provide an API key.")
+	case !strings.HasPrefix(apiKey, "sk_test_"):
+		err = newInvalidRequestError("", "Invalid API Key provided: "+apiKey)
+	default:
+		return nil
+	}
+
+	err.status = http.StatusUnauthorized
+	return
+}
+
+func writeError(w http.ResponseWriter, err *apiError) {
+	var resp errorResponse
+	resp.Error = err
+	writeJSON(w, err.status, resp)
+}
+
+func writeJSON(w http.ResponseWriter, status int, value interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Request-Id", newID("req"))
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(value)
+}
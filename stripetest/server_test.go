@@ -0,0 +1,166 @@
+package stripetest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestServer_pagination(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+
+	var ids []string
+	for i := 0; i < 5; i++ {
+		var c customer
+		do(t, s, "POST", "/v1/customers", url.Values{"email": {"jenny@example.com"}}, 200, &c)
+		ids = append([]string{c.ID}, ids...)
+	}
+
+	type page struct {
+		HasMore bool `json:"has_more"`
+		Data    []struct {
+			ID string `json:"id"`
+		} `json:"data"`
+	}
+
+	var (
+		listed []string
+		after  string
+	)
+
+	for {
+		query := url.Values{"limit": {"2"}}
+		if len(after) > 0 {
+			query.Set("starting_after", after)
+		}
+
+		var p page
+		do(t, s, "GET", "/v1/customers?"+query.Encode(), nil, 200, &p)
+		for _, c := range p.Data {
+			listed = append(listed, c.ID)
+			after = c.ID
+		}
+
+		if !p.HasMore {
+			break
+		}
+	}
+
+	if !reflect.DeepEqual(ids, listed) {
+		t.Fatalf("invalid IDs, expected %v and received %v", ids, listed)
+	}
+
+	var p page
+	do(t, s, "GET", "/v1/customers?limit=2&ending_before="+ids[3], nil, 200, &p)
+	switch {
+	case len(p.Data) != 2 || p.Data[0].ID != ids[1] || p.Data[1].ID != ids[2]:
+		t.Fatalf("invalid page, expected %v and received %+v", ids[1:3], p.Data)
+	case !p.HasMore:
+		t.Fatal("invalid page, expected more results before the page")
+	}
+}
+
+func TestServer_errors(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+
+	var tok token
+	card := url.Values{"card[number]": {"4242424242424242"}, "card[exp_month]": {"12"}, "card[exp_year]": {"2099"}}
+	do(t, s, "POST", "/v1/tokens", card, 200, &tok)
+
+	var ch charge
+	do(t, s, "POST", "/v1/charges", url.Values{"amount": {"1000"}, "currency": {"usd"}, "source": {tok.ID}}, 200, &ch)
+
+	tcs := []struct {
+		method string
+		path   string
+		form   url.Values
+		status int
+		code   string
+	}{
+		{method: "GET", path: "/v1/customers/cus_missing", status: 404, code: "resource_missing"},
+		{method: "GET", path: "/v1/unknown", status: 404},
+		{method: "POST", path: "/v1/tokens", form: url.Values{"card[exp_month]": {"12"}}, status: 400, code: "parameter_missing"},
+		{method: "POST", path: "/v1/tokens", form: url.Values{"card[number]": {"4242424242424241"}}, status: 402, code: "incorrect_number"},
+		{method: "POST", path: "/v1/charges", form: url.Values{"amount": {"10"}, "currency": {"usd"}, "source": {tok.ID}}, status: 400, code: "amount_too_small"},
+		{method: "POST", path: "/v1/charges", form: url.Values{"amount": {"1000"}, "currency": {"usd"}, "source": {tok.ID}}, status: 400, code: "token_already_used"},
+		{method: "POST", path: "/v1/refunds", form: url.Values{"charge": {ch.ID}, "amount": {"1001"}}, status: 400},
+		{method: "POST", path: "/v1/refunds", form: url.Values{"charge": {ch.ID}}, status: 200},
+		{method: "POST", path: "/v1/refunds", form: url.Values{"charge": {ch.ID}}, status: 400, code: "charge_already_refunded"},
+	}
+
+	for _, tc := range tcs {
+		var resp errorResponse
+		do(t, s, tc.method, tc.path, tc.form, tc.status, &resp)
+		if resp.Error != nil && resp.Error.Code != tc.code {
+			t.Fatalf("invalid error code for %s %s, expected <%s> and received <%s>", tc.method, tc.path, tc.code, resp.Error.Code)
+		}
+	}
+}
+
+func TestServer_decline_on_charge(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+
+	var tok token
+	card := url.Values{"card[number]": {"4000000000000341"}, "card[exp_month]": {"12"}, "card[exp_year]": {"2099"}}
+	do(t, s, "POST", "/v1/tokens", card, 200, &tok)
+
+	var resp errorResponse
+	do(t, s, "POST", "/v1/charges", url.Values{"amount": {"1000"}, "currency": {"usd"}, "source": {tok.ID}}, 402, &resp)
+	switch {
+	case resp.Error.DeclineCode != "generic_decline":
+		t.Fatalf("invalid decline code, expected <%s> and received <%s>", "generic_decline", resp.Error.DeclineCode)
+	case len(resp.Error.Charge) == 0:
+		t.Fatal("invalid error, expected the ID of the failed charge")
+	}
+
+	var ch charge
+	do(t, s, "GET", "/v1/charges/"+resp.Error.Charge, nil, 200, &ch)
+	if ch.Status != "failed" {
+		t.Fatalf("invalid charge status, expected <%s> and received <%s>", "failed", ch.Status)
+	}
+}
+
+func TestServer_unauthorized(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+
+	resp, err := http.Get(s.URL + "/v1/customers")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != 401 {
+		t.Fatalf("invalid status code, expected %d and received %d", 401, resp.StatusCode)
+	}
+}
+
+func do(t *testing.T, s *Server, method, path string, form url.Values, status int, value interface{}) {
+	req, err := http.NewRequest(method, s.URL+path, strings.NewReader(form.Encode()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+APIKey)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != status {
+		t.Fatalf("invalid status code for %s %s, expected %d and received %d", method, path, status, resp.StatusCode)
+	}
+
+	if err = json.NewDecoder(resp.Body).Decode(value); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,165 @@
+package stripetest
+
+import (
+	"crypto/rand"
+	"math/big"
+	"net/url"
+	"strconv"
+)
+
+const idAlphabet = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+
+func newStore() *store {
+	var s store
+	s.objects = make(map[string]interface{})
+	return &s
+}
+
+// store holds objects of a single type in creation order, allowing them to be listed newest first
+type store struct {
+	ids     []string
+	objects map[string]interface{}
+}
+
+func (s *store) get(id string) (value interface{}, ok bool) {
+	value, ok = s.objects[id]
+	return
+}
+
+func (s *store) put(id string, value interface{}) {
+	if _, ok := s.objects[id]; !ok {
+		s.ids = append(s.ids, id)
+	}
+
+	s.objects[id] = value
+}
+
+func (s *store) remove(id string) {
+	if _, ok := s.objects[id]; !ok {
+		return
+	}
+
+	delete(s.objects, id)
+	for i, storedID := range s.ids {
+		if storedID == id {
+			s.ids = append(s.ids[:i], s.ids[i+1:]...)
+			return
+		}
+	}
+}
+
+// list will return a page of the objects which match the filter, using the limit, starting_after and ending_before parameters
+func (s *store) list(form url.Values, object, listURL string, filter func(value interface{}) bool) (l *list, err *apiError) {
+	limit := int64(10)
+	if value := form.Get("limit"); len(value) > 0 {
+		var parseErr error
+		if limit, parseErr = strconv.ParseInt(value, 10, 64); parseErr != nil {
+			return nil, newInvalidIntegerError("limit", value)
+		}
+
+		if limit < 1 || limit > 100 {
+			return nil, newCodedError("parameter_invalid_integer", "limit", "This value must be between 1 and 100.")
+		}
+	}
+
+	// Objects are listed newest first
+	var matched []string
+	for i := len(s.ids) - 1; i >= 0; i-- {
+		id := s.ids[i]
+		if filter == nil || filter(s.objects[id]) {
+			matched = append(matched, id)
+		}
+	}
+
+	start, end := 0, len(matched)
+	switch {
+	case len(form.Get("starting_after")) > 0:
+		cursor := form.Get("starting_after")
+		if start = indexOf(matched, cursor) + 1; start == 0 {
+			return nil, newResourceMissingError(object, "starting_after", cursor)
+		}
+	case len(form.Get("ending_before")) > 0:
+		cursor := form.Get("ending_before")
+		if end = indexOf(matched, cursor); end == -1 {
+			return nil, newResourceMissingError(object, "ending_before", cursor)
+		}
+
+		// Pages before a cursor end directly before it
+		if start = end - int(limit); start < 0 {
+			start = 0
+		}
+	}
+
+	l = newList(listURL)
+	for _, id := range matched[start:end] {
+		if int64(len(l.Data)) == limit {
+			break
+		}
+
+		l.Data = append(l.Data, s.objects[id])
+	}
+
+	if len(form.Get("ending_before")) > 0 {
+		l.HasMore = start > 0
+	} else {
+		l.HasMore = start+len(l.Data) < end
+	}
+
+	return
+}
+
+func newList(listURL string) *list {
+	var l list
+	l.Object = "list"
+	l.URL = listURL
+	l.Data = []interface{}{}
+	return &l
+}
+
+type list struct {
+	Object  string        `json:"object"`
+	URL     string        `json:"url"`
+	HasMore bool          `json:"has_more"`
+	Data    []interface{} `json:"data"`
+}
+
+// deleted is returned for deleted objects
+type deleted struct {
+	ID      string `json:"id"`
+	Object  string `json:"object"`
+	Deleted bool   `json:"deleted"`
+}
+
+func newDeleted(id, object string) *deleted {
+	var d deleted
+	d.ID = id
+	d.Object = object
+	d.Deleted = true
+	return &d
+}
+
+func indexOf(ids []string, id string) int {
+	for i, storedID := range ids {
+		if storedID == id {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// newID will return a random ID in the Stripe format, such as cus_4QFJOjw2pOmAGJ
+func newID(prefix string) string {
+	bs := make([]byte, 24)
+	max := big.NewInt(int64(len(idAlphabet)))
+	for i := range bs {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			panic(err)
+		}
+
+		bs[i] = idAlphabet[n.Int64()]
+	}
+
+	return prefix + "_" + string(bs)
+}
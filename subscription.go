@@ -0,0 +1,82 @@
+package stripe
+
+const (
+	SubscriptionStatusIncomplete        = "incomplete"
+	SubscriptionStatusIncompleteExpired = "incomplete_expired"
+	SubscriptionStatusTrialing          = "trialing"
+	SubscriptionStatusActive            = "active"
+	SubscriptionStatusPastDue           = "past_due"
+	SubscriptionStatusCanceled          = "canceled"
+	SubscriptionStatusUnpaid            = "unpaid"
+	SubscriptionStatusPaused            = "paused"
+)
+
+// Subscription represents the recurring billing of a customer
+type Subscription struct {
+	ID     string `json:"id"`
+	Object string `json:"object"`
+
+	// ID of the customer who owns the subscription.
+	Customer string `json:"customer"`
+	// The status of the subscription, see the SubscriptionStatus constants.
+	Status string `json:"status"`
+	// The subscription items of the subscription, see ListSubscriptionItems for further pages.
+	Items SubscriptionItemList `json:"items"`
+	// Three-letter ISO currency code, in lowercase.
+	Currency string `json:"currency"`
+	// Start of the current period that the subscription has been invoiced for.
+	CurrentPeriodStart int64 `json:"current_period_start"`
+	// End of the current period that the subscription has been invoiced for.
+	CurrentPeriodEnd int64 `json:"current_period_end"`
+	// Whether the subscription will be canceled at the end of the current period.
+	CancelAtPeriodEnd bool `json:"cancel_at_period_end"`
+	// The date the subscription was canceled, if it has been canceled.
+	CanceledAt *int64 `json:"canceled_at"`
+	// The ID of the most recent invoice this subscription has generated.
+	LatestInvoice *string `json:"latest_invoice"`
+
+	Metadata Dictionary `json:"metadata"`
+	Livemode bool       `json:"livemode"`
+	Created  int64      `json:"created"`
+}
+
+// SubscriptionSearchResult is a paginated list of Subscriptions matching a search query
+type SubscriptionSearchResult struct {
+	SearchResult
+	Data []Subscription `json:"data"`
+}
+
+// SubscriptionIterator iterates over every Subscription of a paginated list
+type SubscriptionIterator struct {
+	*Iterator
+}
+
+// Subscription returns the Subscription the iterator is currently at
+func (s *SubscriptionIterator) Subscription() (subscription Subscription) {
+	subscription, _ = s.Current().(Subscription)
+	return
+}
+
+func (c *Client) SearchSubscriptions(params SearchParams) (result SubscriptionSearchResult, err error) {
+	err = c.request("GET", endpointSubscriptionsSearch, &params, &result)
+	return
+}
+
+// IterateSubscriptionSearch will return an iterator over every Subscription matching the search query, fetching pages as needed
+func (c *Client) IterateSubscriptionSearch(params SearchParams) *SubscriptionIterator {
+	fetch := func(params SearchParams) (page []interface{}, result SearchResult, err error) {
+		var resp SubscriptionSearchResult
+		if resp, err = c.SearchSubscriptions(params); err != nil {
+			return
+		}
+
+		for _, subscription := range resp.Data {
+			page = append(page, subscription)
+		}
+
+		result = resp.SearchResult
+		return
+	}
+
+	return &SubscriptionIterator{newSearchIterator(params, fetch)}
+}
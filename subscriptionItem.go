@@ -0,0 +1,125 @@
+package stripe
+
+import (
+	"net/url"
+)
+
+const (
+	ProrationBehaviorCreateProrations = "create_prorations"
+	ProrationBehaviorAlwaysInvoice    = "always_invoice"
+	ProrationBehaviorNone             = "none"
+)
+
+// SubscriptionItem represents a single price (and quantity) within a subscription
+type SubscriptionItem struct {
+	ID     string `json:"id"`
+	Object string `json:"object"`
+
+	// The ID of the subscription this item belongs to.
+	Subscription string `json:"subscription"`
+	// The price the customer is subscribed to.
+	Price Price `json:"price"`
+	// The quantity of the price to which the customer should be subscribed. Not set for metered prices.
+	Quantity *int64 `json:"quantity"`
+	// Define thresholds at which an invoice will be sent, and the related subscription advanced to a new billing period.
+	BillingThresholds *SubscriptionItemBillingThresholds `json:"billing_thresholds"`
+
+	Metadata Dictionary `json:"metadata"`
+	Created  int64      `json:"created"`
+}
+
+// SubscriptionItemBillingThresholds represent the usage thresholds of a SubscriptionItem
+type SubscriptionItemBillingThresholds struct {
+	// Usage threshold that triggers the subscription to create an invoice.
+	UsageGTE int64 `json:"usage_gte"`
+}
+
+// SubscriptionItemRequest is used to create or update a SubscriptionItem
+type SubscriptionItemRequest struct {
+	// The identifier of the subscription to modify. Required on creation, ignored on update.
+	Subscription string `json:"subscription"`
+	// The ID of the price object.
+	Price *string `json:"price"`
+	// The quantity you'd like to apply to the subscription item you're creating. Not used for metered prices.
+	Quantity *int64 `json:"quantity"`
+	// Determines how to handle prorations when the billing cycle changes. One of create_prorations, always_invoice or none.
+	ProrationBehavior *string `json:"proration_behavior"`
+	// Usage threshold that triggers the subscription to create an invoice.
+	UsageThreshold *int64 `json:"usage_threshold"`
+
+	Metadata Dictionary `json:"metadata"`
+}
+
+func (s *SubscriptionItemRequest) ToFormValues() (form url.Values) {
+	// Pre-allocate values with enough space for the basic subscription item rows
+	form = make(url.Values, 5)
+	setFormString(form, "subscription", s.Subscription)
+	setFormStringPtr(form, "price", s.Price)
+	setFormInt64Ptr(form, "quantity", s.Quantity)
+	setFormStringPtr(form, "proration_behavior", s.ProrationBehavior)
+	setFormInt64Ptr(form, "billing_thresholds[usage_gte]", s.UsageThreshold)
+	s.Metadata.AppendFormValues(form, "metadata")
+	return
+}
+
+// SubscriptionItemDeleteRequest is used to remove a SubscriptionItem
+type SubscriptionItemDeleteRequest struct {
+	// Delete all usage for the given subscription item. Allowed only when the current plan's usage_type is metered.
+	ClearUsage *bool `json:"clear_usage"`
+	// Determines how to handle prorations when the billing cycle changes. One of create_prorations, always_invoice or none.
+	ProrationBehavior *string `json:"proration_behavior"`
+}
+
+func (s *SubscriptionItemDeleteRequest) ToFormValues() (form url.Values) {
+	form = make(url.Values, 2)
+	setFormBoolPtr(form, "clear_usage", s.ClearUsage)
+	setFormStringPtr(form, "proration_behavior", s.ProrationBehavior)
+	return
+}
+
+// SubscriptionItemListRequest is used to list the SubscriptionItems of a subscription
+type SubscriptionItemListRequest struct {
+	ListParams
+
+	// The ID of the subscription whose items will be retrieved.
+	Subscription string `json:"subscription"`
+}
+
+func (s *SubscriptionItemListRequest) ToFormValues() (form url.Values) {
+	form = s.ListParams.ToFormValues()
+	setFormString(form, "subscription", s.Subscription)
+	return
+}
+
+// SubscriptionItemList is a paginated list of SubscriptionItems
+type SubscriptionItemList struct {
+	List
+	Data []SubscriptionItem `json:"data"`
+}
+
+func (c *Client) CreateSubscriptionItem(request SubscriptionItemRequest) (created SubscriptionItem, err error) {
+	err = c.request("POST", endpointSubscriptionItems, &request, &created)
+	return
+}
+
+func (c *Client) GetSubscriptionItem(subscriptionItemID string) (item SubscriptionItem, err error) {
+	err = c.request("GET", endpointSubscriptionItemsWithID, nil, &item, subscriptionItemID)
+	return
+}
+
+func (c *Client) UpdateSubscriptionItem(subscriptionItemID string, request SubscriptionItemRequest) (updated SubscriptionItem, err error) {
+	// The subscription can only be provided on creation
+	request.Subscription = ""
+	err = c.request("POST", endpointSubscriptionItemsWithID, &request, &updated, subscriptionItemID)
+	return
+}
+
+func (c *Client) RemoveSubscriptionItem(subscriptionItemID string, request SubscriptionItemDeleteRequest) (err error) {
+	err = c.request("DELETE", endpointSubscriptionItemsWithID, &request, nil, subscriptionItemID)
+	return
+}
+
+func (c *Client) ListSubscriptionItems(request SubscriptionItemListRequest) (list SubscriptionItemList, err error) {
+	err = c.request("GET", endpointSubscriptionItems, &request, &list)
+	return
+}
@@ -0,0 +1,27 @@
+package stripe
+
+import (
+	"testing"
+)
+
+func TestClient_UpdateSubscriptionItem(t *testing.T) {
+	s, form := newFormServer(t, `{"id":"si_123","object":"subscription_item"}`)
+	defer s.Close()
+
+	var request SubscriptionItemRequest
+	request.Subscription = "sub_123"
+	request.Price = String("price_123")
+	request.Quantity = Int64(2)
+
+	c := newTestServerClient(t, s.URL)
+	if _, err := c.UpdateSubscriptionItem("si_123", request); err != nil {
+		t.Fatal(err)
+	}
+
+	switch sent := form(); {
+	case len(sent["subscription"]) > 0:
+		t.Fatalf("invalid form, expected subscription to be cleared and received <%s>", sent.Get("subscription"))
+	case sent.Get("price") != "price_123" || sent.Get("quantity") != "2":
+		t.Fatalf("invalid form, expected price and quantity and received %v", sent)
+	}
+}
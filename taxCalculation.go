@@ -0,0 +1,330 @@
+package stripe
+
+import (
+	"fmt"
+	"net/url"
+)
+
+const (
+	TaxBehaviorExclusive = "exclusive"
+	TaxBehaviorInclusive = "inclusive"
+
+	TaxAddressSourceBilling  = "billing"
+	TaxAddressSourceShipping = "shipping"
+
+	TaxTransactionReversalModeFull    = "full"
+	TaxTransactionReversalModePartial = "partial"
+)
+
+// TaxCalculation represents the tax owed on a set of line items for a customer
+type TaxCalculation struct {
+	ID     string `json:"id"`
+	Object string `json:"object"`
+
+	// Three-letter ISO currency code, in lowercase.
+	Currency string `json:"currency"`
+	// The ID of an existing Customer used for the resource.
+	Customer *string `json:"customer"`
+	// The customer details used for the calculation.
+	CustomerDetails TaxCustomerDetails `json:"customer_details"`
+	// Total after taxes.
+	AmountTotal int64 `json:"amount_total"`
+	// The amount of tax to be collected on top of the line item prices.
+	TaxAmountExclusive int64 `json:"tax_amount_exclusive"`
+	// The amount of tax already included in the line item prices.
+	TaxAmountInclusive int64 `json:"tax_amount_inclusive"`
+	// Breakdown of individual tax amounts that add up to the total.
+	TaxBreakdown []TaxBreakdown `json:"tax_breakdown"`
+	// The shipping cost details for the calculation.
+	ShippingCost *TaxShippingCost `json:"shipping_cost"`
+	// Timestamp of date at which the tax rules and rates in effect applies for the calculation.
+	TaxDate int64 `json:"tax_date"`
+	// Timestamp of date at which the tax calculation will expire.
+	ExpiresAt *int64 `json:"expires_at"`
+	// The list of items the customer is purchasing.
+	LineItems *TaxLineItemList `json:"line_items"`
+
+	Livemode bool `json:"livemode"`
+}
+
+// TaxCustomerDetails represent the customer details used for a tax calculation
+type TaxCustomerDetails struct {
+	// The customer's postal address (for example, home or business location).
+	Address *Address `json:"address"`
+	// The type of customer address provided, either billing or shipping.
+	AddressSource *string `json:"address_source"`
+	// The customer's IP address (IPv4 or IPv6).
+	IPAddress *string `json:"ip_address"`
+	// The customer's tax IDs.
+	TaxIDs []TaxIDData `json:"tax_ids"`
+	// The taxability override used for taxation, one of none, customer_exempt, or reverse_charge.
+	TaxabilityOverride *string `json:"taxability_override"`
+}
+
+func (t *TaxCustomerDetails) AppendFormValues(values url.Values, key string) {
+	if t == nil {
+		return
+	}
+
+	t.Address.AppendFormValues(values, getFieldKey(key, "address"))
+	setFormStringPtr(values, getFieldKey(key, "address_source"), t.AddressSource)
+	setFormStringPtr(values, getFieldKey(key, "ip_address"), t.IPAddress)
+	appendTaxIDData(values, getFieldKey(key, "tax_ids"), t.TaxIDs)
+	setFormStringPtr(values, getFieldKey(key, "taxability_override"), t.TaxabilityOverride)
+}
+
+// TaxBreakdown represents an individual tax amount of a TaxCalculation
+type TaxBreakdown struct {
+	// The amount of tax, in the smallest currency unit.
+	Amount int64 `json:"amount"`
+	// Specifies whether the tax amount is included in the line item amount.
+	Inclusive bool `json:"inclusive"`
+	// The reasoning behind this tax, for example, if the product is tax exempt.
+	TaxabilityReason string `json:"taxability_reason"`
+	// The amount on which tax is calculated, in the smallest currency unit.
+	TaxableAmount int64 `json:"taxable_amount"`
+	// The details of the tax rate applied.
+	TaxRateDetails TaxRateDetails `json:"tax_rate_details"`
+}
+
+// TaxRateDetails represent the tax rate applied within a TaxBreakdown
+type TaxRateDetails struct {
+	Country           *string `json:"country"`
+	State             *string `json:"state"`
+	PercentageDecimal string  `json:"percentage_decimal"`
+	TaxType           *string `json:"tax_type"`
+}
+
+// TaxShippingCost represents the shipping cost of a TaxCalculation
+type TaxShippingCost struct {
+	// The shipping amount in the smallest currency unit.
+	Amount int64 `json:"amount"`
+	// The amount of tax calculated for shipping, in the smallest currency unit.
+	AmountTax int64 `json:"amount_tax"`
+	// Specifies whether the amount includes taxes.
+	TaxBehavior *string `json:"tax_behavior"`
+	// The tax code used to calculate tax on shipping.
+	TaxCode *string `json:"tax_code"`
+}
+
+// TaxLineItem represents a single line item of a TaxCalculation or TaxTransaction
+type TaxLineItem struct {
+	ID     string `json:"id"`
+	Object string `json:"object"`
+
+	// The line item amount in the smallest currency unit.
+	Amount int64 `json:"amount"`
+	// The amount of tax calculated for this line item, in the smallest currency unit.
+	AmountTax int64 `json:"amount_tax"`
+	// The ID of an existing Product.
+	Product *string `json:"product"`
+	// The number of units of the item being purchased.
+	Quantity int64 `json:"quantity"`
+	// A custom identifier for this line item.
+	Reference string `json:"reference"`
+	// Specifies whether the amount includes taxes.
+	TaxBehavior string `json:"tax_behavior"`
+	// The tax code ID used for this resource.
+	TaxCode string `json:"tax_code"`
+	// If type=reversal, contains information about what was reversed.
+	Reversal *TaxLineItemReversal `json:"reversal"`
+
+	Metadata Dictionary `json:"metadata"`
+	Livemode bool       `json:"livemode"`
+}
+
+// TaxLineItemReversal references the original line item of a reversed TaxLineItem
+type TaxLineItemReversal struct {
+	OriginalLineItem string `json:"original_line_item"`
+}
+
+// TaxLineItemList is a paginated list of TaxLineItems
+type TaxLineItemList struct {
+	List
+	Data []TaxLineItem `json:"data"`
+}
+
+// TaxCalculationLineItem is used to describe a line item of a TaxCalculationRequest
+type TaxCalculationLineItem struct {
+	// A positive integer representing the line item's total price in the smallest currency unit.
+	Amount int64 `json:"amount"`
+	// A custom identifier for this line item, which must be unique across the line items in the calculation. (Optional)
+	Reference *string `json:"reference"`
+	// The ID of an existing Product. (Optional)
+	Product *string `json:"product"`
+	// The number of units of the item being purchased. Defaults to 1. (Optional)
+	Quantity *int64 `json:"quantity"`
+	// Specifies whether the amount includes taxes, either exclusive or inclusive. Defaults to exclusive. (Optional)
+	TaxBehavior *string `json:"tax_behavior"`
+	// A tax code ID to use for this line item. (Optional)
+	TaxCode *string `json:"tax_code"`
+}
+
+// TaxCalculationRequest is used to create a TaxCalculation
+type TaxCalculationRequest struct {
+	// Three-letter ISO currency code, in lowercase.
+	Currency string `json:"currency"`
+	// A list of items the customer is purchasing.
+	LineItems []TaxCalculationLineItem `json:"line_items"`
+	// The ID of an existing customer to use for this calculation. If provided, the customer's address and tax IDs are copied. (Optional)
+	Customer *string `json:"customer"`
+	// Details about the customer, including address and tax IDs. Required when Customer is not provided.
+	CustomerDetails *TaxCustomerDetails `json:"customer_details"`
+	// The shipping cost amount in the smallest currency unit. (Optional)
+	ShippingCost *int64 `json:"shipping_cost"`
+	// Timestamp of date at which the tax rules and rates in effect applies for the calculation. (Optional)
+	TaxDate *int64 `json:"tax_date"`
+}
+
+func (t *TaxCalculationRequest) ToFormValues() (form url.Values) {
+	// Pre-allocate values with enough space for a single line item calculation
+	form = make(url.Values, 4)
+	setFormString(form, "currency", t.Currency)
+	setFormStringPtr(form, "customer", t.Customer)
+	setFormInt64Ptr(form, "shipping_cost[amount]", t.ShippingCost)
+	setFormInt64Ptr(form, "tax_date", t.TaxDate)
+	t.CustomerDetails.AppendFormValues(form, "customer_details")
+	for i, item := range t.LineItems {
+		key := fmt.Sprintf("line_items[%d]", i)
+		setFormInt64(form, getFieldKey(key, "amount"), item.Amount)
+		setFormStringPtr(form, getFieldKey(key, "reference"), item.Reference)
+		setFormStringPtr(form, getFieldKey(key, "product"), item.Product)
+		setFormInt64Ptr(form, getFieldKey(key, "quantity"), item.Quantity)
+		setFormStringPtr(form, getFieldKey(key, "tax_behavior"), item.TaxBehavior)
+		setFormStringPtr(form, getFieldKey(key, "tax_code"), item.TaxCode)
+	}
+
+	return
+}
+
+// TaxTransaction represents a recorded TaxCalculation, or a reversal of one
+type TaxTransaction struct {
+	ID     string `json:"id"`
+	Object string `json:"object"`
+
+	// Either transaction or reversal.
+	Type string `json:"type"`
+	// A custom unique identifier, such as 'myOrder_123'.
+	Reference string `json:"reference"`
+	// Three-letter ISO currency code, in lowercase.
+	Currency string `json:"currency"`
+	// The ID of an existing Customer used for the resource.
+	Customer *string `json:"customer"`
+	// The customer details used for the transaction.
+	CustomerDetails TaxCustomerDetails `json:"customer_details"`
+	// The shipping cost details for the transaction.
+	ShippingCost *TaxShippingCost `json:"shipping_cost"`
+	// Timestamp of date at which the tax rules and rates in effect applies for the calculation.
+	TaxDate int64 `json:"tax_date"`
+	// If type=reversal, contains information about what was reversed.
+	Reversal *TaxTransactionReversal `json:"reversal"`
+	// The tax collected or refunded, by line item.
+	LineItems *TaxLineItemList `json:"line_items"`
+
+	Metadata Dictionary `json:"metadata"`
+	Livemode bool       `json:"livemode"`
+	Created  int64      `json:"created"`
+}
+
+// TaxTransactionReversal references the original transaction of a reversed TaxTransaction
+type TaxTransactionReversal struct {
+	OriginalTransaction *string `json:"original_transaction"`
+}
+
+// TaxTransactionRequest is used to create a TaxTransaction from a TaxCalculation
+type TaxTransactionRequest struct {
+	// Tax Calculation ID to be used as input when creating the transaction.
+	Calculation string `json:"calculation"`
+	// A custom order or sale identifier, such as 'myOrder_123'. Must be unique across all transactions, including reversals.
+	Reference string `json:"reference"`
+
+	Metadata Dictionary `json:"metadata"`
+}
+
+func (t *TaxTransactionRequest) ToFormValues() (form url.Values) {
+	form = make(url.Values, 2)
+	setFormString(form, "calculation", t.Calculation)
+	setFormString(form, "reference", t.Reference)
+	t.Metadata.AppendFormValues(form, "metadata")
+	return
+}
+
+// TaxReversalLineItem is used to describe a reversed line item of a TaxReversalRequest
+type TaxReversalLineItem struct {
+	// The amount to reverse, in the smallest currency unit, as a negative integer.
+	Amount int64 `json:"amount"`
+	// The amount of tax to reverse, in the smallest currency unit, as a negative integer.
+	AmountTax int64 `json:"amount_tax"`
+	// The ID of the line item to reverse in the original transaction.
+	OriginalLineItem string `json:"original_line_item"`
+	// A custom identifier for this line item in the reversal transaction, such as 'L1-refund'.
+	Reference string `json:"reference"`
+	// The quantity reversed. Appears in tax exports, but does not affect the amount of tax reversed. (Optional)
+	Quantity *int64 `json:"quantity"`
+}
+
+// TaxReversalRequest is used to partially or fully reverse a TaxTransaction
+type TaxReversalRequest struct {
+	// If partial, the provided line item or shipping cost amounts are reversed. If full, the original transaction is fully reversed.
+	Mode string `json:"mode"`
+	// The ID of the Transaction to partially or fully reverse.
+	OriginalTransaction string `json:"original_transaction"`
+	// A custom identifier for this reversal, such as myOrder_123-refund_1, which must be unique across all transactions.
+	Reference string `json:"reference"`
+	// The line item amounts to reverse. Only used when mode is partial. (Optional)
+	LineItems []TaxReversalLineItem `json:"line_items"`
+	// A flat amount to reverse across the entire transaction, as a negative integer. Only used when mode is partial. (Optional)
+	FlatAmount *int64 `json:"flat_amount"`
+
+	Metadata Dictionary `json:"metadata"`
+}
+
+func (t *TaxReversalRequest) ToFormValues() (form url.Values) {
+	// Pre-allocate values with enough space for a full reversal
+	form = make(url.Values, 3)
+	setFormString(form, "mode", t.Mode)
+	setFormString(form, "original_transaction", t.OriginalTransaction)
+	setFormString(form, "reference", t.Reference)
+	setFormInt64Ptr(form, "flat_amount", t.FlatAmount)
+	for i, item := range t.LineItems {
+		key := fmt.Sprintf("line_items[%d]", i)
+		setFormInt64(form, getFieldKey(key, "amount"), item.Amount)
+		setFormInt64(form, getFieldKey(key, "amount_tax"), item.AmountTax)
+		setFormString(form, getFieldKey(key, "original_line_item"), item.OriginalLineItem)
+		setFormString(form, getFieldKey(key, "reference"), item.Reference)
+		setFormInt64Ptr(form, getFieldKey(key, "quantity"), item.Quantity)
+	}
+
+	t.Metadata.AppendFormValues(form, "metadata")
+	return
+}
+
+func (c *Client) CreateTaxCalculation(request TaxCalculationRequest) (created TaxCalculation, err error) {
+	err = c.request("POST", endpointTaxCalculations, &request, &created)
+	return
+}
+
+func (c *Client) ListTaxCalculationLineItems(calculationID string, params ListParams) (list TaxLineItemList, err error) {
+	err = c.request("GET", endpointTaxCalculationLineItemsWithID, &params, &list, calculationID)
+	return
+}
+
+func (c *Client) CreateTaxTransaction(request TaxTransactionRequest) (created TaxTransaction, err error) {
+	err = c.request("POST", endpointTaxTransactionsFromCalculation, &request, &created)
+	return
+}
+
+func (c *Client) CreateTaxReversal(request TaxReversalRequest) (created TaxTransaction, err error) {
+	err = c.request("POST", endpointTaxTransactionsReversal, &request, &created)
+	return
+}
+
+func (c *Client) GetTaxTransaction(transactionID string) (transaction TaxTransaction, err error) {
+	err = c.request("GET", endpointTaxTransactionsWithID, nil, &transaction, transactionID)
+	return
+}
+
+func (c *Client) ListTaxTransactionLineItems(transactionID string, params ListParams) (list TaxLineItemList, err error) {
+	err = c.request("GET", endpointTaxTransactionLineItemsWithID, &params, &list, transactionID)
+	return
+}
@@ -0,0 +1,37 @@
+package stripe
+
+import (
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+func TestTaxCalculationRequest_ToFormValues(t *testing.T) {
+	var req TaxCalculationRequest
+	req.Currency = "eur"
+	req.LineItems = []TaxCalculationLineItem{
+		{Amount: 1000, Reference: String("L1"), TaxBehavior: String(TaxBehaviorExclusive)},
+	}
+
+	req.CustomerDetails = &TaxCustomerDetails{
+		Address:       &Address{Country: "DE", PostalCode: "10115"},
+		AddressSource: String(TaxAddressSourceBilling),
+		TaxIDs:        []TaxIDData{{Type: TaxIDTypeEUVAT, Value: "DE123456789"}},
+	}
+
+	wanted := url.Values{
+		"currency":                               {"eur"},
+		"line_items[0][amount]":                  {"1000"},
+		"line_items[0][reference]":               {"L1"},
+		"line_items[0][tax_behavior]":            {"exclusive"},
+		"customer_details[address][country]":     {"DE"},
+		"customer_details[address][postal_code]": {"10115"},
+		"customer_details[address_source]":       {"billing"},
+		"customer_details[tax_ids][0][type]":     {"eu_vat"},
+		"customer_details[tax_ids][0][value]":    {"DE123456789"},
+	}
+
+	if form := req.ToFormValues(); !reflect.DeepEqual(wanted, form) {
+		t.Fatalf("invalid form values, expected %v and received %v", wanted, form)
+	}
+}
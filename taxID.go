@@ -0,0 +1,167 @@
+package stripe
+
+import (
+	"fmt"
+	"net/url"
+)
+
+const (
+	TaxIDTypeADNRT    = "ad_nrt"
+	TaxIDTypeAETRN    = "ae_trn"
+	TaxIDTypeARCUIT   = "ar_cuit"
+	TaxIDTypeAUABN    = "au_abn"
+	TaxIDTypeAUARN    = "au_arn"
+	TaxIDTypeBGUIC    = "bg_uic"
+	TaxIDTypeBHVAT    = "bh_vat"
+	TaxIDTypeBOTIN    = "bo_tin"
+	TaxIDTypeBRCNPJ   = "br_cnpj"
+	TaxIDTypeBRCPF    = "br_cpf"
+	TaxIDTypeCABN     = "ca_bn"
+	TaxIDTypeCAGSTHST = "ca_gst_hst"
+	TaxIDTypeCAPSTBC  = "ca_pst_bc"
+	TaxIDTypeCAPSTMB  = "ca_pst_mb"
+	TaxIDTypeCAPSTSK  = "ca_pst_sk"
+	TaxIDTypeCAQST    = "ca_qst"
+	TaxIDTypeCHUID    = "ch_uid"
+	TaxIDTypeCHVAT    = "ch_vat"
+	TaxIDTypeCLTIN    = "cl_tin"
+	TaxIDTypeCNTIN    = "cn_tin"
+	TaxIDTypeCONIT    = "co_nit"
+	TaxIDTypeCRTIN    = "cr_tin"
+	TaxIDTypeDESTN    = "de_stn"
+	TaxIDTypeDORCN    = "do_rcn"
+	TaxIDTypeECRUC    = "ec_ruc"
+	TaxIDTypeEGTIN    = "eg_tin"
+	TaxIDTypeESCIF    = "es_cif"
+	TaxIDTypeEUOSSVAT = "eu_oss_vat"
+	TaxIDTypeEUVAT    = "eu_vat"
+	TaxIDTypeGBVAT    = "gb_vat"
+	TaxIDTypeGEVAT    = "ge_vat"
+	TaxIDTypeHKBR     = "hk_br"
+	TaxIDTypeHUTIN    = "hu_tin"
+	TaxIDTypeIDNPWP   = "id_npwp"
+	TaxIDTypeILVAT    = "il_vat"
+	TaxIDTypeINGST    = "in_gst"
+	TaxIDTypeISVAT    = "is_vat"
+	TaxIDTypeJPCN     = "jp_cn"
+	TaxIDTypeJPRN     = "jp_rn"
+	TaxIDTypeJPTRN    = "jp_trn"
+	TaxIDTypeKEPIN    = "ke_pin"
+	TaxIDTypeKRBRN    = "kr_brn"
+	TaxIDTypeKZBIN    = "kz_bin"
+	TaxIDTypeLIUID    = "li_uid"
+	TaxIDTypeMXRFC    = "mx_rfc"
+	TaxIDTypeMYFRP    = "my_frp"
+	TaxIDTypeMYITN    = "my_itn"
+	TaxIDTypeMYSST    = "my_sst"
+	TaxIDTypeNGTIN    = "ng_tin"
+	TaxIDTypeNOVAT    = "no_vat"
+	TaxIDTypeNOVOEC   = "no_voec"
+	TaxIDTypeNZGST    = "nz_gst"
+	TaxIDTypeOMVAT    = "om_vat"
+	TaxIDTypePERUC    = "pe_ruc"
+	TaxIDTypePHTIN    = "ph_tin"
+	TaxIDTypeROTIN    = "ro_tin"
+	TaxIDTypeRSPIB    = "rs_pib"
+	TaxIDTypeRUINN    = "ru_inn"
+	TaxIDTypeRUKPP    = "ru_kpp"
+	TaxIDTypeSAVAT    = "sa_vat"
+	TaxIDTypeSGGST    = "sg_gst"
+	TaxIDTypeSGUEN    = "sg_uen"
+	TaxIDTypeSITIN    = "si_tin"
+	TaxIDTypeSVNIT    = "sv_nit"
+	TaxIDTypeTHVAT    = "th_vat"
+	TaxIDTypeTRTIN    = "tr_tin"
+	TaxIDTypeTWVAT    = "tw_vat"
+	TaxIDTypeUAVAT    = "ua_vat"
+	TaxIDTypeUSEIN    = "us_ein"
+	TaxIDTypeUYRUC    = "uy_ruc"
+	TaxIDTypeVERIF    = "ve_rif"
+	TaxIDTypeVNTIN    = "vn_tin"
+	TaxIDTypeZAVAT    = "za_vat"
+	TaxIDTypeUnknown  = "unknown"
+
+	TaxIDVerificationStatusPending     = "pending"
+	TaxIDVerificationStatusVerified    = "verified"
+	TaxIDVerificationStatusUnverified  = "unverified"
+	TaxIDVerificationStatusUnavailable = "unavailable"
+)
+
+// TaxID represents a tax identification number of a Customer
+type TaxID struct {
+	ID     string `json:"id"`
+	Object string `json:"object"`
+
+	// The ID of the customer the tax ID belongs to.
+	Customer *string `json:"customer"`
+	// Two-letter ISO code representing the country of the tax ID.
+	Country *string `json:"country"`
+	// Type of the tax ID, see the TaxIDType constants.
+	Type string `json:"type"`
+	// Value of the tax ID.
+	Value string `json:"value"`
+	// Tax ID verification information.
+	Verification *TaxIDVerification `json:"verification"`
+
+	Livemode bool  `json:"livemode"`
+	Created  int64 `json:"created"`
+}
+
+// TaxIDVerification represents the verification state of a TaxID
+type TaxIDVerification struct {
+	// Verification status, one of pending, verified, unverified, or unavailable.
+	Status string `json:"status"`
+	// Verified address.
+	VerifiedAddress *string `json:"verified_address"`
+	// Verified name.
+	VerifiedName *string `json:"verified_name"`
+}
+
+// TaxIDData is used to create a TaxID
+type TaxIDData struct {
+	// Type of the tax ID, see the TaxIDType constants.
+	Type string `json:"type"`
+	// Value of the tax ID.
+	Value string `json:"value"`
+}
+
+func (t *TaxIDData) ToFormValues() (form url.Values) {
+	form = make(url.Values, 2)
+	setFormString(form, "type", t.Type)
+	setFormString(form, "value", t.Value)
+	return
+}
+
+// TaxIDList is a paginated list of TaxIDs
+type TaxIDList struct {
+	List
+	Data []TaxID `json:"data"`
+}
+
+func (c *Client) CreateTaxID(stripeUserID string, data TaxIDData) (created TaxID, err error) {
+	err = c.request("POST", endpointTaxIDsWithID, &data, &created, stripeUserID)
+	return
+}
+
+func (c *Client) GetTaxID(stripeUserID, taxID string) (retrieved TaxID, err error) {
+	err = c.request("GET", endpointTaxIDsWithIDAndTaxID, nil, &retrieved, stripeUserID, taxID)
+	return
+}
+
+func (c *Client) RemoveTaxID(stripeUserID, taxID string) (err error) {
+	err = c.request("DELETE", endpointTaxIDsWithIDAndTaxID, nil, nil, stripeUserID, taxID)
+	return
+}
+
+func (c *Client) ListTaxIDs(stripeUserID string, params ListParams) (list TaxIDList, err error) {
+	err = c.request("GET", endpointTaxIDsWithID, &params, &list, stripeUserID)
+	return
+}
+
+func appendTaxIDData(values url.Values, key string, data []TaxIDData) {
+	for i, d := range data {
+		fieldKey := fmt.Sprintf("%s[%d]", key, i)
+		setFormString(values, getFieldKey(fieldKey, "type"), d.Type)
+		setFormString(values, getFieldKey(fieldKey, "value"), d.Value)
+	}
+}
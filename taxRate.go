@@ -0,0 +1,148 @@
+package stripe
+
+import (
+	"net/url"
+)
+
+const (
+	TaxTypeAmusementTax      = "amusement_tax"
+	TaxTypeCommunicationsTax = "communications_tax"
+	TaxTypeGST               = "gst"
+	TaxTypeHST               = "hst"
+	TaxTypeIGST              = "igst"
+	TaxTypeJCT               = "jct"
+	TaxTypeLeaseTax          = "lease_tax"
+	TaxTypePST               = "pst"
+	TaxTypeQST               = "qst"
+	TaxTypeRST               = "rst"
+	TaxTypeSalesTax          = "sales_tax"
+	TaxTypeVAT               = "vat"
+)
+
+// TaxRate represents a tax rate which can be applied to invoices, subscriptions and checkout sessions
+type TaxRate struct {
+	ID     string `json:"id"`
+	Object string `json:"object"`
+
+	// The display name of the tax rates as it will appear to your customer on their receipt email, PDF, and the hosted invoice page.
+	DisplayName string `json:"display_name"`
+	// An arbitrary string attached to the tax rate for your internal use only.
+	Description *string `json:"description"`
+	// This represents the tax rate percent out of 100.
+	Percentage float64 `json:"percentage"`
+	// This specifies if the tax rate is inclusive or exclusive.
+	Inclusive bool `json:"inclusive"`
+	// Defaults to true. When set to false, this tax rate cannot be used with new applications or Checkout Sessions.
+	Active bool `json:"active"`
+	// Two-letter country code (ISO 3166-1 alpha-2).
+	Country *string `json:"country"`
+	// ISO 3166-2 subdivision code, without country prefix. For example, "NY" for New York, United States.
+	State *string `json:"state"`
+	// The jurisdiction for the tax rate. You can use this label field for tax reporting purposes.
+	Jurisdiction *string `json:"jurisdiction"`
+	// The high-level tax type, see the TaxType constants.
+	TaxType *string `json:"tax_type"`
+
+	Metadata Dictionary `json:"metadata"`
+	Livemode bool       `json:"livemode"`
+	Created  int64      `json:"created"`
+}
+
+// TaxRateRequest is used to create or update a TaxRate
+// Percentage and Inclusive cannot be changed once a TaxRate has been created
+type TaxRateRequest struct {
+	// The display name of the tax rate, which will be shown to users.
+	DisplayName *string `json:"display_name"`
+	// This represents the tax rate percent out of 100. Required on creation.
+	Percentage *float64 `json:"percentage"`
+	// This specifies if the tax rate is inclusive or exclusive. Required on creation.
+	Inclusive *bool `json:"inclusive"`
+	// Flag determining whether the tax rate is active or inactive (archived). (Optional)
+	Active *bool `json:"active"`
+	// An arbitrary string attached to the tax rate for your internal use only. (Optional)
+	Description *string `json:"description"`
+	// Two-letter country code (ISO 3166-1 alpha-2). (Optional)
+	Country *string `json:"country"`
+	// ISO 3166-2 subdivision code, without country prefix. (Optional)
+	State *string `json:"state"`
+	// The jurisdiction for the tax rate. (Optional)
+	Jurisdiction *string `json:"jurisdiction"`
+	// The high-level tax type, see the TaxType constants. (Optional)
+	TaxType *string `json:"tax_type"`
+
+	Metadata Dictionary `json:"metadata"`
+}
+
+func (t *TaxRateRequest) ToFormValues() (form url.Values) {
+	// Pre-allocate values with enough space for the basic tax rate rows
+	form = make(url.Values, 3)
+	setFormStringPtr(form, "display_name", t.DisplayName)
+	setFormFloat64Ptr(form, "percentage", t.Percentage)
+	setFormBoolPtr(form, "inclusive", t.Inclusive)
+	setFormBoolPtr(form, "active", t.Active)
+	setFormStringPtr(form, "description", t.Description)
+	setFormStringPtr(form, "country", t.Country)
+	setFormStringPtr(form, "state", t.State)
+	setFormStringPtr(form, "jurisdiction", t.Jurisdiction)
+	setFormStringPtr(form, "tax_type", t.TaxType)
+	t.Metadata.AppendFormValues(form, "metadata")
+	return
+}
+
+// TaxRateListRequest is used to list TaxRates
+type TaxRateListRequest struct {
+	ListParams
+
+	// Optional flag to filter by tax rates that are either active or inactive (archived). (Optional)
+	Active *bool `json:"active"`
+	// Optional flag to filter by tax rates that are inclusive (or those that are not inclusive). (Optional)
+	Inclusive *bool `json:"inclusive"`
+	// Only return tax rates that were created during the given date interval. (Optional)
+	Created *RangeQuery `json:"created"`
+}
+
+func (t *TaxRateListRequest) ToFormValues() (form url.Values) {
+	form = t.ListParams.ToFormValues()
+	setFormBoolPtr(form, "active", t.Active)
+	setFormBoolPtr(form, "inclusive", t.Inclusive)
+	t.Created.AppendFormValues(form, "created")
+	return
+}
+
+// TaxRateList is a paginated list of TaxRates
+type TaxRateList struct {
+	List
+	Data []TaxRate `json:"data"`
+}
+
+func (c *Client) CreateTaxRate(request TaxRateRequest) (created TaxRate, err error) {
+	err = c.request("POST", endpointTaxRates, &request, &created)
+	return
+}
+
+func (c *Client) GetTaxRate(taxRateID string) (taxRate TaxRate, err error) {
+	err = c.request("GET", endpointTaxRatesWithID, nil, &taxRate, taxRateID)
+	return
+}
+
+func (c *Client) UpdateTaxRate(taxRateID string, request TaxRateRequest) (updated TaxRate, err error) {
+	// Percentage and inclusive cannot be updated
+	request.Percentage = nil
+	request.Inclusive = nil
+
+	err = c.request("POST", endpointTaxRatesWithID, &request, &updated, taxRateID)
+	return
+}
+
+// ArchiveTaxRate will deactivate a TaxRate, tax rates cannot be deleted
+func (c *Client) ArchiveTaxRate(taxRateID string) (updated TaxRate, err error) {
+	var req TaxRateRequest
+	req.Active = Bool(false)
+	err = c.request("POST", endpointTaxRatesWithID, &req, &updated, taxRateID)
+	return
+}
+
+func (c *Client) ListTaxRates(request TaxRateListRequest) (list TaxRateList, err error) {
+	err = c.request("GET", endpointTaxRates, &request, &list)
+	return
+}
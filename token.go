@@ -1,14 +1,19 @@
 package stripe
 
-import "net/url"
+import (
+	"fmt"
+	"net/url"
+)
 
-// Token represents a stripe card token
+// Token represents a stripe card or bank account token
 type Token struct {
 	ID     string `json:"id"`
 	Object string `json:"object"`
 	Type   string `json:"type"`
 
 	Card Card `json:"card"`
+	// Set instead of Card when tokenizing a bank account
+	BankAccount *BankAccount `json:"bank_account"`
 
 	ClientIP *string `json:"client_ip"`
 	Livemode *bool   `json:"livemode"`
@@ -20,6 +25,44 @@ type Token struct {
 func (t *Token) ToFormValues() (form url.Values) {
 	// Pre-allocate values with enough space for the basic user information rows
 	form = make(url.Values, 1)
+	if t.BankAccount != nil {
+		t.BankAccount.AppendFormValues(form, "bank_account")
+		return
+	}
+
 	t.Card.AppendFormValues(form, "card")
 	return
 }
+
+// String returns the Token with its card, bank account and routing numbers masked, making it safe to log
+func (t Token) String() string {
+	return fmt.Sprintf("%+v", t.redacted())
+}
+
+// Format ensures card, bank account and routing numbers are never printed, regardless of the formatting verb
+func (t Token) Format(f fmt.State, verb rune) {
+	fmt.Fprintf(f, formatDirective(f, verb), t.redacted())
+}
+
+// Redacted returns a copy of the Token with its card, bank account and routing numbers masked
+// JSON encoding is left untouched, encode the redacted copy when a Token is logged as JSON
+func (t Token) Redacted() Token {
+	t.Card = t.Card.Redacted()
+	if t.BankAccount != nil {
+		bankAccount := *t.BankAccount
+		bankAccount.AccountNumber = maskNumber(bankAccount.AccountNumber)
+		if bankAccount.RoutingNumber != nil {
+			bankAccount.RoutingNumber = String(maskNumber(*bankAccount.RoutingNumber))
+		}
+		t.BankAccount = &bankAccount
+	}
+
+	return t
+}
+
+// redactedToken has the fields of a Token without its methods, allowing it to be printed as is
+type redactedToken Token
+
+func (t Token) redacted() redactedToken {
+	return redactedToken(t.Redacted())
+}
@@ -0,0 +1,32 @@
+package stripe
+
+import (
+	"context"
+	"strings"
+)
+
+// Tracer starts a span for every request performed by a Client, see the stripeotel package for an OpenTelemetry Tracer
+type Tracer interface {
+	// StartSpan is called before a request is sent, the returned context is used to perform the request
+	StartSpan(ctx context.Context, info RequestInfo) (context.Context, Span)
+}
+
+// Span is the span of a single request started by a Tracer
+type Span interface {
+	// End is called once the request has completed, the error is nil for successful requests
+	End(info ResponseInfo, err error)
+}
+
+// WithTracer will start a span with the provided Tracer for every request performed by the Client
+// Spans are children of the context provided to WithContext
+func WithTracer(t Tracer) Option {
+	return func(c *Client) (err error) {
+		c.tracer = t
+		return
+	}
+}
+
+// getRoute returns the endpoint constant of a request with its %s verbs replaced by {id}
+func getRoute(endpoint string) string {
+	return strings.Replace(endpoint, "%s", "{id}", -1)
+}
@@ -0,0 +1,101 @@
+package stripe
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/luxraise/stripe/stripetest"
+)
+
+type contextKey string
+
+type testTracer struct {
+	parents []context.Context
+	infos   []ResponseInfo
+	errs    []error
+}
+
+func (t *testTracer) StartSpan(ctx context.Context, info RequestInfo) (context.Context, Span) {
+	t.parents = append(t.parents, ctx)
+	return context.WithValue(ctx, contextKey("span"), len(t.parents)), &testSpan{t: t}
+}
+
+type testSpan struct {
+	t *testTracer
+}
+
+func (s *testSpan) End(info ResponseInfo, err error) {
+	s.t.infos = append(s.t.infos, info)
+	s.t.errs = append(s.t.errs, err)
+}
+
+func TestWithTracer(t *testing.T) {
+	s := stripetest.NewServer()
+	defer s.Close()
+
+	var tracer testTracer
+	c, err := New(stripetest.APIKey, WithHost(s.URL), WithTracer(&tracer))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.WithValue(context.Background(), contextKey("caller"), "checkout")
+	if _, err = c.WithContext(ctx).ForAccount("acct_1032D82eZvKYlo2C").GetCustomer("cus_NffrFeUfNV2Hib"); err == nil {
+		t.Fatal("expected error for missing customer and received nil")
+	}
+
+	var stripeErr *Error
+	switch {
+	case len(tracer.parents) != 1 || len(tracer.infos) != 1:
+		t.Fatalf("invalid number of spans, expected 1 and received %d started and %d ended", len(tracer.parents), len(tracer.infos))
+	case tracer.parents[0].Value(contextKey("caller")) != "checkout":
+		t.Fatal("invalid span parent, expected the context provided to WithContext")
+	case tracer.infos[0].Route != "/customers/{id}":
+		t.Fatalf("invalid route, expected <%s> and received <%s>", "/customers/{id}", tracer.infos[0].Route)
+	case tracer.infos[0].StatusCode != 404 || tracer.infos[0].AccountID != "acct_1032D82eZvKYlo2C":
+		t.Fatalf("invalid span info, received %+v", tracer.infos[0])
+	case !errors.As(tracer.errs[0], &stripeErr) || stripeErr.Code != "resource_missing":
+		t.Fatalf("invalid span error, expected a resource_missing error and received <%v>", tracer.errs[0])
+	}
+}
+
+func TestClient_WithContext_canceled(t *testing.T) {
+	s := stripetest.NewServer()
+	defer s.Close()
+
+	c, err := New(stripetest.APIKey, WithHost(s.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Nanosecond)
+	defer cancel()
+	<-ctx.Done()
+
+	if _, err = c.WithContext(ctx).GetCustomer("cus_NffrFeUfNV2Hib"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("invalid error, expected <%v> and received <%v>", context.DeadlineExceeded, err)
+	}
+}
+
+func Test_getRoute(t *testing.T) {
+	tcs := []struct {
+		endpoint string
+		expected string
+	}{
+		{endpoint: endpointCustomers, expected: "/customers"},
+		{endpoint: endpointCustomersSearch, expected: "/customers/search"},
+		{endpoint: endpointCustomersWithID, expected: "/customers/{id}"},
+		{endpoint: endpointTaxIDsWithIDAndTaxID, expected: "/customers/{id}/tax_ids/{id}"},
+		{endpoint: endpointAccountsRejectWithID, expected: "/accounts/{id}/reject"},
+		{endpoint: endpointCapabilitiesWithIDAndCapability, expected: "/accounts/{id}/capabilities/{id}"},
+		{endpoint: endpointBillingPortalSessions, expected: "/billing_portal/sessions"},
+	}
+
+	for _, tc := range tcs {
+		if route := getRoute(tc.endpoint); route != tc.expected {
+			t.Fatalf("invalid route for <%s>, expected <%s> and received <%s>", tc.endpoint, tc.expected, route)
+		}
+	}
+}
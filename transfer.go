@@ -0,0 +1,134 @@
+package stripe
+
+import (
+	"net/url"
+)
+
+// Transfer represents funds being moved from the platform balance to a connected Account
+type Transfer struct {
+	ID     string `json:"id"`
+	Object string `json:"object"`
+
+	// Amount in cents (or local equivalent) to be transferred.
+	Amount int64 `json:"amount"`
+	// Amount in cents (or local equivalent) reversed (can be less than the amount attribute on the transfer if a partial reversal was issued).
+	AmountReversed int64 `json:"amount_reversed"`
+	// Three-letter ISO currency code, in lowercase.
+	Currency string `json:"currency"`
+	// ID of the Stripe account the transfer was sent to.
+	Destination *string `json:"destination"`
+	// If the destination is a Stripe account, this will be the ID of the payment that the destination account received for the transfer.
+	DestinationPayment *string `json:"destination_payment"`
+	// Balance transaction that describes the impact of this transfer on your account balance.
+	BalanceTransaction *string `json:"balance_transaction"`
+	// ID of the charge that was used to fund the transfer. If null, the transfer was funded from the available balance.
+	SourceTransaction *string `json:"source_transaction"`
+	// The source balance this transfer came from. One of card, fpx, or bank_account.
+	SourceType *string `json:"source_type"`
+	// A string that identifies this transaction as part of a group.
+	TransferGroup *string `json:"transfer_group"`
+	// An arbitrary string attached to the object. Often useful for displaying to users.
+	Description *string `json:"description"`
+	// A list of reversals that have been applied to the transfer.
+	Reversals TransferReversalList `json:"reversals"`
+	// Whether the transfer has been fully reversed. If the transfer is only partially reversed, this attribute will still be false.
+	Reversed bool `json:"reversed"`
+
+	Metadata Dictionary `json:"metadata"`
+	Livemode bool       `json:"livemode"`
+	Created  int64      `json:"created"`
+}
+
+// TransferRequest is used to create or update a Transfer
+type TransferRequest struct {
+	// A positive integer in cents (or local equivalent) representing how much to transfer.
+	Amount int64 `json:"amount"`
+	// Three-letter ISO currency code, in lowercase.
+	Currency string `json:"currency"`
+	// The ID of a connected Stripe account.
+	Destination string `json:"destination"`
+	// You can use this parameter to transfer funds from a charge before they are added to your available balance. (Optional)
+	SourceTransaction *string `json:"source_transaction"`
+	// A string that identifies this transaction as part of a group. (Optional)
+	TransferGroup *string `json:"transfer_group"`
+	// An arbitrary string attached to the object. Often useful for displaying to users. (Optional)
+	Description *string `json:"description"`
+
+	Metadata Dictionary `json:"metadata"`
+}
+
+func (t *TransferRequest) ToFormValues() (form url.Values) {
+	// Pre-allocate values with enough space for the basic transfer rows
+	form = make(url.Values, 3)
+	setFormInt64(form, "amount", t.Amount)
+	setFormString(form, "currency", t.Currency)
+	setFormString(form, "destination", t.Destination)
+	setFormStringPtr(form, "source_transaction", t.SourceTransaction)
+	setFormStringPtr(form, "transfer_group", t.TransferGroup)
+	setFormStringPtr(form, "description", t.Description)
+	t.Metadata.AppendFormValues(form, "metadata")
+	return
+}
+
+// TransferListRequest is used to list Transfers
+type TransferListRequest struct {
+	ListParams
+
+	// Only return transfers for the destination specified by this account ID. (Optional)
+	Destination *string `json:"destination"`
+	// Only return transfers with the specified transfer group. (Optional)
+	TransferGroup *string `json:"transfer_group"`
+	// Only return transfers that were created during the given date interval. (Optional)
+	Created *RangeQuery `json:"created"`
+}
+
+func (t *TransferListRequest) ToFormValues() (form url.Values) {
+	form = t.ListParams.ToFormValues()
+	setFormStringPtr(form, "destination", t.Destination)
+	setFormStringPtr(form, "transfer_group", t.TransferGroup)
+	t.Created.AppendFormValues(form, "created")
+	return
+}
+
+// TransferList is a paginated list of Transfers
+type TransferList struct {
+	List
+	Data []Transfer `json:"data"`
+}
+
+func (c *Client) CreateTransfer(request TransferRequest) (created Transfer, err error) {
+	err = c.request("POST", endpointTransfers, &request, &created)
+	return
+}
+
+func (c *Client) GetTransfer(transferID string) (transfer Transfer, err error) {
+	err = c.request("GET", endpointTransfersWithID, nil, &transfer, transferID)
+	return
+}
+
+func (c *Client) UpdateTransfer(transferID string, request TransferRequest) (updated Transfer, err error) {
+	// Only the description and metadata of a transfer can be updated
+	var req updateTransferRequest
+	req.Description = request.Description
+	req.Metadata = request.Metadata
+
+	err = c.request("POST", endpointTransfersWithID, &req, &updated, transferID)
+	return
+}
+
+func (c *Client) ListTransfers(request TransferListRequest) (list TransferList, err error) {
+	err = c.request("GET", endpointTransfers, &request, &list)
+	return
+}
+
+type updateTransferRequest struct {
+	Description *string    `json:"description"`
+	Metadata    Dictionary `json:"metadata"`
+}
+
+func (u *updateTransferRequest) ToFormValues() (form url.Values) {
+	form = make(url.Values, 1)
+	setFormStringPtr(form, "description", u.Description)
+	u.Metadata.AppendFormValues(form, "metadata")
+	return
+}
@@ -0,0 +1,78 @@
+package stripe
+
+import (
+	"net/url"
+)
+
+// TransferReversal represents the return of funds from a connected Account to the platform for a Transfer
+type TransferReversal struct {
+	ID     string `json:"id"`
+	Object string `json:"object"`
+
+	// Amount, in cents (or local equivalent).
+	Amount int64 `json:"amount"`
+	// Three-letter ISO currency code, in lowercase.
+	Currency string `json:"currency"`
+	// Balance transaction that describes the impact on your account balance.
+	BalanceTransaction *string `json:"balance_transaction"`
+	// Linked payment refund for the transfer reversal.
+	DestinationPaymentRefund *string `json:"destination_payment_refund"`
+	// ID of the refund responsible for the transfer reversal.
+	SourceRefund *string `json:"source_refund"`
+	// ID of the transfer that was reversed.
+	Transfer string `json:"transfer"`
+
+	Metadata Dictionary `json:"metadata"`
+	Created  int64      `json:"created"`
+}
+
+// TransferReversalRequest is used to create or update a TransferReversal
+type TransferReversalRequest struct {
+	// A positive integer in cents (or local equivalent) representing how much of this transfer to reverse. Defaults to the entire transfer amount. (Optional)
+	Amount *int64 `json:"amount"`
+	// An arbitrary string which you can attach to a reversal object. (Optional)
+	Description *string `json:"description"`
+	// Boolean indicating whether the application fee should be refunded when reversing this transfer. (Optional)
+	RefundApplicationFee *bool `json:"refund_application_fee"`
+
+	Metadata Dictionary `json:"metadata"`
+}
+
+func (t *TransferReversalRequest) ToFormValues() (form url.Values) {
+	form = make(url.Values, 3)
+	setFormInt64Ptr(form, "amount", t.Amount)
+	setFormStringPtr(form, "description", t.Description)
+	setFormBoolPtr(form, "refund_application_fee", t.RefundApplicationFee)
+	t.Metadata.AppendFormValues(form, "metadata")
+	return
+}
+
+// TransferReversalList is a paginated list of TransferReversals
+type TransferReversalList struct {
+	List
+	Data []TransferReversal `json:"data"`
+}
+
+func (c *Client) CreateTransferReversal(transferID string, request TransferReversalRequest) (created TransferReversal, err error) {
+	err = c.request("POST", endpointTransferReversalsWithID, &request, &created, transferID)
+	return
+}
+
+func (c *Client) GetTransferReversal(transferID, reversalID string) (reversal TransferReversal, err error) {
+	err = c.request("GET", endpointTransferReversalsWithIDAndReversalID, nil, &reversal, transferID, reversalID)
+	return
+}
+
+func (c *Client) UpdateTransferReversal(transferID, reversalID string, metadata Dictionary) (updated TransferReversal, err error) {
+	// Only the metadata of a transfer reversal can be updated
+	var req TransferReversalRequest
+	req.Metadata = metadata
+
+	err = c.request("POST", endpointTransferReversalsWithIDAndReversalID, &req, &updated, transferID, reversalID)
+	return
+}
+
+func (c *Client) ListTransferReversals(transferID string, params ListParams) (list TransferReversalList, err error) {
+	err = c.request("GET", endpointTransferReversalsWithID, &params, &list, transferID)
+	return
+}
@@ -0,0 +1,34 @@
+package stripe
+
+import (
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+func TestClient_UpdateTransfer(t *testing.T) {
+	s, form := newFormServer(t, `{"id":"tr_123","object":"transfer"}`)
+	defer s.Close()
+
+	var req TransferRequest
+	req.Amount = 1000
+	req.Currency = "usd"
+	req.Destination = "acct_123"
+	req.Description = String("Payout for order 42")
+	req.Metadata = Dictionary{"order": "42"}
+
+	c := newTestServerClient(t, s.URL)
+	if _, err := c.UpdateTransfer("tr_123", req); err != nil {
+		t.Fatal(err)
+	}
+
+	// The amount, currency and destination of a transfer cannot be updated
+	wanted := url.Values{
+		"description":     {"Payout for order 42"},
+		"metadata[order]": {"42"},
+	}
+
+	if sent := form(); !reflect.DeepEqual(wanted, sent) {
+		t.Fatalf("invalid form values, expected %v and received %v", wanted, sent)
+	}
+}
@@ -0,0 +1,98 @@
+package stripe
+
+import (
+	"net/url"
+)
+
+const (
+	UsageRecordActionIncrement = "increment"
+	UsageRecordActionSet       = "set"
+)
+
+// UsageRecord represents the usage of a metered SubscriptionItem at a given point in time
+type UsageRecord struct {
+	ID     string `json:"id"`
+	Object string `json:"object"`
+
+	// The ID of the subscription item this usage record contains data for.
+	SubscriptionItem string `json:"subscription_item"`
+	// The usage quantity for the specified date.
+	Quantity int64 `json:"quantity"`
+	// The timestamp when this usage occurred.
+	Timestamp int64 `json:"timestamp"`
+
+	Livemode bool `json:"livemode"`
+}
+
+// UsageRecordRequest is used to report usage for a metered SubscriptionItem
+type UsageRecordRequest struct {
+	// The usage quantity for the specified timestamp.
+	Quantity int64 `json:"quantity"`
+	// Valid values are increment (default) or set. When using increment the specified quantity will be added to the usage at the specified timestamp. The set action will overwrite the usage quantity at that timestamp.
+	Action *string `json:"action"`
+	// The timestamp for the usage event. When not provided, the current time is used.
+	Timestamp *int64 `json:"timestamp"`
+
+	// Key sent as the Idempotency-Key header, allowing the request to be safely retried. (Optional)
+	IdempotencyKey string `json:"-"`
+}
+
+func (u *UsageRecordRequest) ToFormValues() (form url.Values) {
+	form = make(url.Values, 3)
+	setFormInt64(form, "quantity", u.Quantity)
+	setFormStringPtr(form, "action", u.Action)
+	setFormInt64Ptr(form, "timestamp", u.Timestamp)
+	return
+}
+
+func (u *UsageRecordRequest) idempotencyKey() string {
+	return u.IdempotencyKey
+}
+
+// UsageRecordSummary represents the aggregated usage of a SubscriptionItem for a billing period
+type UsageRecordSummary struct {
+	ID     string `json:"id"`
+	Object string `json:"object"`
+
+	// The invoice in which this usage period has been billed for.
+	Invoice *string `json:"invoice"`
+	// The billing period of the summary.
+	Period Period `json:"period"`
+	// The ID of the subscription item this summary is describing.
+	SubscriptionItem string `json:"subscription_item"`
+	// The total usage within this usage period.
+	TotalUsage int64 `json:"total_usage"`
+
+	Livemode bool `json:"livemode"`
+}
+
+// Period represents a span of time using unix timestamps
+type Period struct {
+	Start *int64 `json:"start"`
+	End   *int64 `json:"end"`
+}
+
+func (p *Period) AppendFormValues(values url.Values, key string) {
+	if p == nil {
+		return
+	}
+
+	setFormInt64Ptr(values, getFieldKey(key, "start"), p.Start)
+	setFormInt64Ptr(values, getFieldKey(key, "end"), p.End)
+}
+
+// UsageRecordSummaryList is a paginated list of UsageRecordSummaries
+type UsageRecordSummaryList struct {
+	List
+	Data []UsageRecordSummary `json:"data"`
+}
+
+func (c *Client) CreateUsageRecord(subscriptionItemID string, request UsageRecordRequest) (created UsageRecord, err error) {
+	err = c.request("POST", endpointUsageRecordsWithID, &request, &created, subscriptionItemID)
+	return
+}
+
+func (c *Client) ListUsageRecordSummaries(subscriptionItemID string, params ListParams) (list UsageRecordSummaryList, err error) {
+	err = c.request("GET", endpointUsageRecordSummariesWithID, &params, &list, subscriptionItemID)
+	return
+}
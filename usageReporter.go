@@ -0,0 +1,229 @@
+package stripe
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// ErrUsageReporterClosed is returned when usage is reported to a closed UsageReporter
+var ErrUsageReporterClosed = errors.New("usage reporter has been closed")
+
+const (
+	// maxUsageAttempts is the number of times a batch is reported before it is dropped
+	maxUsageAttempts = 5
+	// usageBatchTTL is how long a batch is retried for, it is kept within the 24 hour lifetime of Stripe idempotency keys
+	// Retrying with an expired idempotency key could report the same usage twice
+	usageBatchTTL = 23 * time.Hour
+)
+
+// NewUsageReporter initializes and returns a new UsageReporter
+// Aggregated usage is flushed every interval, an interval of zero disables automatic flushing
+func NewUsageReporter(client *Client, interval time.Duration) *UsageReporter {
+	var u UsageReporter
+	u.c = client
+	u.pending = make(map[string]int64)
+	u.closeC = make(chan struct{})
+	if interval > 0 {
+		u.wg.Add(1)
+		go u.loop(interval)
+	}
+
+	return &u
+}
+
+// UsageReporter aggregates usage per SubscriptionItem in memory and reports it as usage records in batches
+type UsageReporter struct {
+	mux sync.Mutex
+	// Flush mutex ensures only one flush is sending batches at a time
+	fmux sync.Mutex
+	wg   sync.WaitGroup
+
+	c *Client
+
+	// Aggregated quantities which have not yet been assigned to a batch, keyed by subscription item ID
+	pending map[string]int64
+	// Batches which have been assigned an idempotency key but have not yet been successfully reported
+	queue []UsageBatch
+
+	closeC chan struct{}
+	closed bool
+
+	// OnError is called with any errors encountered during an automatic flush (Optional)
+	OnError func(error)
+	// OnDrop is called with every batch which is dropped without being reported, along with the error of its last attempt (Optional)
+	OnDrop func(batch UsageBatch, err error)
+}
+
+// Report will add the provided quantity to the aggregated usage of a SubscriptionItem
+func (u *UsageReporter) Report(subscriptionItemID string, quantity int64) (err error) {
+	u.mux.Lock()
+	defer u.mux.Unlock()
+	if u.closed {
+		return ErrUsageReporterClosed
+	}
+
+	u.pending[subscriptionItemID] += quantity
+	return
+}
+
+// Flush will report all aggregated usage
+// Batches which fail to report are kept, along with their idempotency key, and retried on the next flush
+// Batches are dropped when they are rejected by Stripe (such as for a missing subscription item), after
+// several failed attempts, or once their idempotency key is close to expiring. Dropped batches are passed to OnDrop.
+func (u *UsageReporter) Flush() (err error) {
+	u.fmux.Lock()
+	defer u.fmux.Unlock()
+
+	var queue []UsageBatch
+	if queue, err = u.seal(); err != nil {
+		return
+	}
+
+	var (
+		failed  []UsageBatch
+		errs    []string
+		dropped int
+	)
+
+	for _, batch := range queue {
+		err := batch.report(u.c)
+		if err == nil {
+			continue
+		}
+
+		errs = append(errs, fmt.Sprintf("%s: %v", batch.SubscriptionItemID, err))
+		if batch.shouldDrop(err) {
+			dropped++
+			u.drop(batch, err)
+			continue
+		}
+
+		failed = append(failed, batch)
+	}
+
+	u.mux.Lock()
+	// Failed batches are placed ahead of anything sealed since, keeping report order intact
+	u.queue = append(failed, u.queue...)
+	u.mux.Unlock()
+
+	if len(errs) > 0 {
+		return fmt.Errorf("error reporting usage for %d subscription item(s), %d dropped: %v", len(errs), dropped, errs)
+	}
+
+	return
+}
+
+// Close will stop automatic flushing and perform a final flush of all aggregated usage
+func (u *UsageReporter) Close() (err error) {
+	u.mux.Lock()
+	if u.closed {
+		u.mux.Unlock()
+		return ErrUsageReporterClosed
+	}
+
+	u.closed = true
+	close(u.closeC)
+	u.mux.Unlock()
+
+	u.wg.Wait()
+	return u.Flush()
+}
+
+// seal moves all pending usage into idempotency keyed batches and returns the full queue
+func (u *UsageReporter) seal() (queue []UsageBatch, err error) {
+	u.mux.Lock()
+	defer u.mux.Unlock()
+
+	now := time.Now().Unix()
+	for subscriptionItemID, quantity := range u.pending {
+		var batch UsageBatch
+		if batch.IdempotencyKey, err = newIdempotencyKey(); err != nil {
+			return
+		}
+
+		batch.SubscriptionItemID = subscriptionItemID
+		batch.Quantity = quantity
+		batch.Timestamp = now
+		u.queue = append(u.queue, batch)
+		delete(u.pending, subscriptionItemID)
+	}
+
+	queue = u.queue
+	u.queue = nil
+	return
+}
+
+func (u *UsageReporter) drop(batch UsageBatch, err error) {
+	if u.OnDrop != nil {
+		u.OnDrop(batch, err)
+	}
+}
+
+func (u *UsageReporter) loop(interval time.Duration) {
+	defer u.wg.Done()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := u.Flush(); err != nil && u.OnError != nil {
+				u.OnError(err)
+			}
+		case <-u.closeC:
+			return
+		}
+	}
+}
+
+// UsageBatch is the aggregated usage of a SubscriptionItem which is reported as a single usage record
+type UsageBatch struct {
+	SubscriptionItemID string
+	Quantity           int64
+	// Unix timestamp of when the batch was sealed, used as the timestamp of the usage record
+	Timestamp      int64
+	IdempotencyKey string
+	// Number of times the batch has been reported
+	Attempts int
+}
+
+func (b *UsageBatch) report(c *Client) (err error) {
+	b.Attempts++
+
+	var req UsageRecordRequest
+	req.Quantity = b.Quantity
+	req.Action = String(UsageRecordActionIncrement)
+	req.Timestamp = Int64(b.Timestamp)
+	req.IdempotencyKey = b.IdempotencyKey
+	_, err = c.CreateUsageRecord(b.SubscriptionItemID, req)
+	return
+}
+
+// shouldDrop returns whether a batch which failed to report should no longer be retried
+func (b *UsageBatch) shouldDrop(err error) bool {
+	var stripeErr *Error
+	switch {
+	case errors.As(err, &stripeErr):
+		// Rejected by Stripe, the request will fail the same way when retried
+		return true
+	case b.Attempts >= maxUsageAttempts:
+		return true
+	default:
+		return time.Since(time.Unix(b.Timestamp, 0)) >= usageBatchTTL
+	}
+}
+
+func newIdempotencyKey() (key string, err error) {
+	bs := make([]byte, 16)
+	if _, err = rand.Read(bs); err != nil {
+		err = fmt.Errorf("error generating idempotency key: %v", err)
+		return
+	}
+
+	key = hex.EncodeToString(bs)
+	return
+}
@@ -0,0 +1,164 @@
+package stripe
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestUsageReporter(t *testing.T) {
+	var (
+		mux      sync.Mutex
+		keys     []string
+		quantity []string
+		fail     = true
+	)
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mux.Lock()
+		defer mux.Unlock()
+		if err := r.ParseForm(); err != nil {
+			t.Error(err)
+			w.WriteHeader(500)
+			return
+		}
+
+		keys = append(keys, r.Header.Get("Idempotency-Key"))
+		quantity = append(quantity, r.PostForm.Get("quantity"))
+		if fail {
+			w.WriteHeader(500)
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"id":"mbur_123","object":"usage_record"}`))
+	}))
+	defer s.Close()
+
+	c := newTestServerClient(t, s.URL)
+	u := NewUsageReporter(c, 0)
+	if err := u.Report("si_123", 2); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := u.Report("si_123", 3); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := u.Flush(); err == nil {
+		t.Fatal("expected error for failed flush and received nil")
+	}
+
+	mux.Lock()
+	fail = false
+	mux.Unlock()
+
+	if err := u.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	mux.Lock()
+	defer mux.Unlock()
+	switch {
+	case len(keys) != 2:
+		t.Fatalf("invalid number of requests, expected %d and received %d", 2, len(keys))
+	case len(keys[0]) == 0:
+		t.Fatal("empty idempotency key encountered")
+	case keys[0] != keys[1]:
+		t.Fatalf("invalid idempotency key, expected <%s> and received <%s>", keys[0], keys[1])
+	case quantity[1] != "5":
+		t.Fatalf("invalid quantity, expected <%s> and received <%s>", "5", quantity[1])
+	}
+
+	if err := u.Report("si_123", 1); err != ErrUsageReporterClosed {
+		t.Fatalf("invalid error, expected %v and received %v", ErrUsageReporterClosed, err)
+	}
+}
+
+func TestUsageReporter_drop(t *testing.T) {
+	var (
+		mux      sync.Mutex
+		requests = make(map[string]int)
+	)
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mux.Lock()
+		defer mux.Unlock()
+		requests[r.URL.Path]++
+		if strings.Contains(r.URL.Path, "si_missing") {
+			w.WriteHeader(404)
+			_, _ = w.Write([]byte(`{"error":{"type":"invalid_request_error","code":"resource_missing"}}`))
+			return
+		}
+
+		w.WriteHeader(500)
+	}))
+	defer s.Close()
+
+	var dropped []UsageBatch
+	u := NewUsageReporter(newTestServerClient(t, s.URL), 0)
+	u.OnDrop = func(batch UsageBatch, err error) {
+		dropped = append(dropped, batch)
+	}
+
+	if err := u.Report("si_missing", 1); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := u.Report("si_unavailable", 2); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := u.Flush(); err == nil {
+		t.Fatal("expected error for failed flush and received nil")
+	}
+
+	if len(dropped) != 1 || dropped[0].SubscriptionItemID != "si_missing" {
+		t.Fatalf("invalid dropped batches, expected <si_missing> to be dropped and received %+v", dropped)
+	}
+
+	for i := 1; i < maxUsageAttempts; i++ {
+		if err := u.Flush(); err == nil {
+			t.Fatal("expected error for failed flush and received nil")
+		}
+	}
+
+	switch {
+	case len(dropped) != 2 || dropped[1].SubscriptionItemID != "si_unavailable":
+		t.Fatalf("invalid dropped batches, expected <si_unavailable> to be dropped and received %+v", dropped)
+	case dropped[1].Attempts != maxUsageAttempts:
+		t.Fatalf("invalid attempts, expected %d and received %d", maxUsageAttempts, dropped[1].Attempts)
+	}
+
+	if err := u.Flush(); err != nil {
+		t.Fatalf("invalid error, expected nil once every batch was dropped and received %v", err)
+	}
+
+	mux.Lock()
+	defer mux.Unlock()
+	if n := requests["/v1/subscription_items/si_missing/usage_records"]; n != 1 {
+		t.Fatalf("invalid number of requests for a missing subscription item, expected %d and received %d", 1, n)
+	}
+}
+
+func TestUsageBatch_shouldDrop(t *testing.T) {
+	tcs := []struct {
+		name     string
+		batch    UsageBatch
+		err      error
+		expected bool
+	}{
+		{name: "server error", batch: UsageBatch{Attempts: 1, Timestamp: timeNow()}, err: errors.New("unexpected status code of: 500"), expected: false},
+		{name: "rejected", batch: UsageBatch{Attempts: 1, Timestamp: timeNow()}, err: &Error{Type: "invalid_request_error"}, expected: true},
+		{name: "attempts", batch: UsageBatch{Attempts: maxUsageAttempts, Timestamp: timeNow()}, err: errors.New("timeout"), expected: true},
+		{name: "expired", batch: UsageBatch{Attempts: 1, Timestamp: timeNow() - 24*60*60}, err: errors.New("timeout"), expected: true},
+	}
+
+	for _, tc := range tcs {
+		if dropped := tc.batch.shouldDrop(tc.err); dropped != tc.expected {
+			t.Fatalf("invalid drop for %s, expected %v and received %v", tc.name, tc.expected, dropped)
+		}
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"mime/multipart"
 	"net/url"
 	"strconv"
 	"strings"
@@ -22,11 +23,62 @@ type Request interface {
 	ToFormValues() url.Values
 }
 
+// multipartRequest is implemented by requests which are sent as multipart/form-data, such as file uploads
+type multipartRequest interface {
+	writeMultipart(w *multipart.Writer) error
+}
+
+// idempotentRequest is implemented by requests which can be safely retried using an idempotency key
+type idempotentRequest interface {
+	idempotencyKey() string
+}
+
 // String will return a string pointer
 func String(str string) *string {
 	return &str
 }
 
+// Int64 will return an int64 pointer
+func Int64(value int64) *int64 {
+	return &value
+}
+
+// Float64 will return a float64 pointer
+func Float64(value float64) *float64 {
+	return &value
+}
+
+// Bool will return a bool pointer
+func Bool(value bool) *bool {
+	return &value
+}
+
+// List represents the shared fields of a paginated list response
+type List struct {
+	Object  string `json:"object"`
+	URL     string `json:"url"`
+	HasMore bool   `json:"has_more"`
+}
+
+// ListParams represent the cursor pagination parameters shared by list endpoints
+type ListParams struct {
+	// A limit on the number of objects to be returned. Limit can range between 1 and 100, and the default is 10.
+	Limit *int64 `json:"limit"`
+	// A cursor for use in pagination. StartingAfter is an object ID that defines your place in the list.
+	StartingAfter *string `json:"starting_after"`
+	// A cursor for use in pagination. EndingBefore is an object ID that defines your place in the list.
+	EndingBefore *string `json:"ending_before"`
+}
+
+func (l *ListParams) ToFormValues() (form url.Values) {
+	// Pre-allocate values with enough space for the pagination rows
+	form = make(url.Values, 3)
+	setFormInt64Ptr(form, "limit", l.Limit)
+	setFormStringPtr(form, "starting_after", l.StartingAfter)
+	setFormStringPtr(form, "ending_before", l.EndingBefore)
+	return
+}
+
 type listCardsResponse struct {
 	Object  string `json:"object"`
 	URL     string `json:"url"`
@@ -34,8 +86,31 @@ type listCardsResponse struct {
 	Data    []Card `json:"data"`
 }
 
-func getRequestBody(request Request) (body io.Reader) {
-	if request == nil {
+// RangeQuery represents a range filter for list endpoints, such as the created timestamp
+type RangeQuery struct {
+	// Minimum value to filter by (exclusive)
+	GT *int64 `json:"gt"`
+	// Minimum value to filter by (inclusive)
+	GTE *int64 `json:"gte"`
+	// Maximum value to filter by (exclusive)
+	LT *int64 `json:"lt"`
+	// Maximum value to filter by (inclusive)
+	LTE *int64 `json:"lte"`
+}
+
+func (r *RangeQuery) AppendFormValues(form url.Values, key string) {
+	if r == nil {
+		return
+	}
+
+	setFormInt64Ptr(form, getFieldKey(key, "gt"), r.GT)
+	setFormInt64Ptr(form, getFieldKey(key, "gte"), r.GTE)
+	setFormInt64Ptr(form, getFieldKey(key, "lt"), r.LT)
+	setFormInt64Ptr(form, getFieldKey(key, "lte"), r.LTE)
+}
+
+func getRequestBody(method string, request Request) (body io.Reader) {
+	if request == nil || !hasRequestBody(method) {
 		return
 	}
 
@@ -44,6 +119,28 @@ func getRequestBody(request Request) (body io.Reader) {
 	return
 }
 
+// getMultipartBody streams the multipart body of a request through a pipe, so files are never held in memory
+// Any error writing the body is returned when the body is read
+func getMultipartBody(request multipartRequest) (body io.ReadCloser, contentType string) {
+	r, w := io.Pipe()
+	mw := multipart.NewWriter(w)
+	go func() {
+		err := request.writeMultipart(mw)
+		if err == nil {
+			err = mw.Close()
+		}
+
+		if err != nil {
+			err = fmt.Errorf("error writing multipart body: %v", err)
+		}
+
+		// Closing with a nil error ends the body with io.EOF
+		_ = w.CloseWithError(err)
+	}()
+
+	return r, mw.FormDataContentType()
+}
+
 func handleResponse(r io.Reader, value interface{}) (err error) {
 	if value == nil {
 		return
@@ -96,6 +193,22 @@ func setFormInt64(form url.Values, key string, value int64) {
 	form.Set(key, strconv.FormatInt(value, 10))
 }
 
+func setFormBoolPtr(form url.Values, key string, value *bool) {
+	if value == nil {
+		return
+	}
+
+	form.Set(key, strconv.FormatBool(*value))
+}
+
+func setFormFloat64Ptr(form url.Values, key string, value *float64) {
+	if value == nil {
+		return
+	}
+
+	form.Set(key, strconv.FormatFloat(*value, 'f', -1, 64))
+}
+
 func setFormInt64Ptr(form url.Values, key string, value *int64) {
 	if value == nil {
 		return
@@ -104,6 +217,18 @@ func setFormInt64Ptr(form url.Values, key string, value *int64) {
 	setFormInt64(form, key, *value)
 }
 
+// hasRequestBody returns whether or not the provided method sends it's parameters as a form body.
+// GET and DELETE requests send their parameters as query string values instead.
+func hasRequestBody(method string) bool {
+	switch method {
+	case "GET", "DELETE":
+		return false
+
+	default:
+		return true
+	}
+}
+
 func getFieldKey(key, field string) string {
 	return fmt.Sprintf("%s[%s]", key, field)
 }